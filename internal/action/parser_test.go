@@ -6,71 +6,95 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/tmknom/actdocs/internal/conf"
+	"github.com/tmknom/actdocs/internal/model"
+	"github.com/tmknom/actdocs/internal/util"
 )
 
 func TestParser_Parse(t *testing.T) {
 	cases := []struct {
 		name     string
 		fixture  string
-		expected *AST
+		expected *model.Document
 	}{
 		{
 			name:    "empty parameter",
 			fixture: emptyActionFixture,
-			expected: &AST{
+			expected: &model.Document{
+				Kind:        util.ActionKind,
 				Name:        NewNullValue(),
 				Description: NewNullValue(),
-				Inputs: []*InputAST{
-					{"empty", NewNullValue(), NewNullValue(), NewNullValue()},
+				Inputs: []*model.Input{
+					{Name: "empty", Default: NewNullValue(), Description: NewNullValue(), Required: NewNullValue(), Type: NewNullValue(), DeprecationMessage: NewNullValue()},
 				},
-				Outputs: []*OutputAST{
-					{"only-value", NewNullValue()},
+				Secrets: []*model.Secret{},
+				Outputs: []*model.Output{
+					{Name: "only-value", Description: NewNullValue()},
 				},
-				Runs: &RunsAST{Using: "undefined", Steps: []*any{}},
+				Permissions: []*model.Permission{},
 			},
 		},
 		{
 			name:    "full parameter",
 			fixture: fullActionFixture,
-			expected: &AST{
+			expected: &model.Document{
+				Kind:        util.ActionKind,
 				Name:        NewNotNullValue("Test Fixture"),
 				Description: NewNotNullValue("This is a test Custom Action for actdocs."),
-				Inputs: []*InputAST{
-					{"full-number", NewNotNullValue("5"), NewNotNullValue("The full number value."), NewNotNullValue("false")},
+				Inputs: []*model.Input{
+					{Name: "full-number", Default: NewNotNullValue("5"), Description: NewNotNullValue("The full number value."), Required: NewNotNullValue("false"), Type: NewNullValue(), DeprecationMessage: NewNotNullValue("Use other instead.")},
 				},
-				Outputs: []*OutputAST{
-					{"with-description", NewNotNullValue("The Render value with description.")},
+				Secrets: []*model.Secret{},
+				Outputs: []*model.Output{
+					{Name: "with-description", Description: NewNotNullValue("The Render value with description.")},
 				},
-				Runs: &RunsAST{Using: "undefined", Steps: []*any{}},
+				Permissions: []*model.Permission{},
 			},
 		},
 		{
 			name:    "complex parameter",
 			fixture: complexActionFixture,
-			expected: &AST{
+			expected: &model.Document{
+				Kind:        util.ActionKind,
 				Name:        NewNotNullValue("Test Fixture"),
 				Description: NewNotNullValue("This is a test Custom Action for actdocs."),
-				Inputs: []*InputAST{
-					{"full-string", NewNotNullValue("Default value"), NewNotNullValue("The full string value."), NewNotNullValue("true")},
-					{"full-boolean", NewNotNullValue("true"), NewNotNullValue("The full boolean value."), NewNotNullValue("false")},
-					{"empty", NewNullValue(), NewNullValue(), NewNullValue()},
+				Inputs: []*model.Input{
+					{Name: "full-string", Default: NewNotNullValue("Default value"), Description: NewNotNullValue("The full string value."), Required: NewNotNullValue("true"), Type: NewNullValue(), DeprecationMessage: NewNullValue()},
+					{Name: "full-boolean", Default: NewNotNullValue("true"), Description: NewNotNullValue("The full boolean value."), Required: NewNotNullValue("false"), Type: NewNullValue(), DeprecationMessage: NewNullValue()},
+					{Name: "empty", Default: NewNullValue(), Description: NewNullValue(), Required: NewNullValue(), Type: NewNullValue(), DeprecationMessage: NewNullValue()},
 				},
-				Outputs: []*OutputAST{
-					{"with-description", NewNotNullValue("The Render value with description.")},
-					{"only-value", NewNullValue()},
+				Secrets: []*model.Secret{},
+				Outputs: []*model.Output{
+					{Name: "with-description", Description: NewNotNullValue("The Render value with description.")},
+					{Name: "only-value", Description: NewNullValue()},
 				},
-				Runs: &RunsAST{Using: "undefined", Steps: []*any{}},
+				Permissions: []*model.Permission{},
 			},
 		},
 		{
 			name:    "invalid YAML",
 			fixture: invalidActionFixture,
-			expected: &AST{
+			expected: &model.Document{
+				Kind:        util.ActionKind,
 				Name:        NewNotNullValue("Test"),
 				Description: NewNullValue(),
-				Inputs:      []*InputAST{},
-				Outputs:     []*OutputAST{},
-				Runs:        &RunsAST{Using: "undefined", Steps: []*any{}},
+				Inputs:      []*model.Input{},
+				Secrets:     []*model.Secret{},
+				Outputs:     []*model.Output{},
+				Permissions: []*model.Permission{},
+			},
+		},
+		{
+			name:    "runs",
+			fixture: runsActionFixture,
+			expected: &model.Document{
+				Kind:        util.ActionKind,
+				Name:        NewNotNullValue("Test Runs"),
+				Description: NewNullValue(),
+				Inputs:      []*model.Input{},
+				Secrets:     []*model.Secret{},
+				Outputs:     []*model.Output{},
+				Permissions: []*model.Permission{},
+				Runtime:     "composite",
 			},
 		},
 	}
@@ -82,8 +106,8 @@ func TestParser_Parse(t *testing.T) {
 			t.Fatalf("%s: unexpected error: %s", tc.name, err)
 		}
 
-		sortInput := func(a, b *InputAST) bool { return a.Name < b.Name }
-		sortOutput := func(a, b *OutputAST) bool { return a.Name < b.Name }
+		sortInput := func(a, b *model.Input) bool { return a.Name < b.Name }
+		sortOutput := func(a, b *model.Output) bool { return a.Name < b.Name }
 		if diff := cmp.Diff(got, tc.expected, cmpopts.SortSlices(sortInput), cmpopts.SortSlices(sortOutput)); diff != "" {
 			t.Errorf("%s: diff: %s", tc.name, diff)
 		}
@@ -111,6 +135,7 @@ inputs:
     default: 5
     required: false
     description: "The full number value."
+    deprecationMessage: "Use other instead."
 
 outputs:
   with-description:
@@ -141,6 +166,16 @@ outputs:
     value: "The Render value without description."
 `
 
+const runsActionFixture = `
+name: Test Runs
+
+runs:
+  using: composite
+  steps:
+    - run: echo "test"
+      shell: bash
+`
+
 const invalidActionFixture = `
 name: Test
 on:
@@ -150,3 +185,36 @@ on:
         type: number
         description: "The full number value."
 `
+
+func TestParser_ParseWithDeclarationOrder(t *testing.T) {
+	fixture := `
+inputs:
+  zulu:
+  alpha:
+  mike:
+outputs:
+  second:
+  first:
+`
+	for i := 0; i < 10; i++ {
+		got, err := NewParser(conf.DefaultSortConfig()).Parse([]byte(fixture))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		inputs := []string{}
+		for _, input := range got.Inputs {
+			inputs = append(inputs, input.Name)
+		}
+		outputs := []string{}
+		for _, output := range got.Outputs {
+			outputs = append(outputs, output.Name)
+		}
+		if diff := cmp.Diff(inputs, []string{"zulu", "alpha", "mike"}); diff != "" {
+			t.Fatalf("inputs diff: %s", diff)
+		}
+		if diff := cmp.Diff(outputs, []string{"second", "first"}); diff != "" {
+			t.Fatalf("outputs diff: %s", diff)
+		}
+	}
+}
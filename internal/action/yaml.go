@@ -1,24 +1,27 @@
 package action
 
+import "github.com/tmknom/actdocs/internal/util"
+
 type Yaml struct {
-	Name        *string                `yaml:"name"`
-	Description *string                `yaml:"description"`
-	Inputs      map[string]*InputYaml  `yaml:"inputs"`
-	Outputs     map[string]*OutputYaml `yaml:"outputs"`
-	Runs        *RunsYaml              `yaml:"runs"`
+	Name        *string                       `yaml:"name"`
+	Description *string                       `yaml:"description"`
+	Inputs      *util.OrderedMap[*InputYaml]  `yaml:"inputs"`
+	Outputs     *util.OrderedMap[*OutputYaml] `yaml:"outputs"`
+	Runs        *RunsYaml                     `yaml:"runs"`
 }
 
 func NewYaml() *Yaml {
 	return &Yaml{
-		Inputs:  map[string]*InputYaml{},
-		Outputs: map[string]*OutputYaml{},
+		Inputs:  util.NewOrderedMap[*InputYaml](),
+		Outputs: util.NewOrderedMap[*OutputYaml](),
 	}
 }
 
 type InputYaml struct {
-	Default     *string `mapstructure:"default"`
-	Description *string `mapstructure:"description"`
-	Required    *string `mapstructure:"required"`
+	Default            *string `mapstructure:"default"`
+	Description        *string `mapstructure:"description"`
+	Required           *string `mapstructure:"required"`
+	DeprecationMessage *string `yaml:"deprecationMessage"`
 }
 
 type OutputYaml struct {
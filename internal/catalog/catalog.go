@@ -0,0 +1,130 @@
+package catalog
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/tmknom/actdocs/internal/action"
+	"github.com/tmknom/actdocs/internal/conf"
+	"github.com/tmknom/actdocs/internal/util"
+	"github.com/tmknom/actdocs/internal/workflow"
+)
+
+type Catalog struct {
+	Entries []*Entry
+}
+
+func NewCatalog() *Catalog {
+	return &Catalog{
+		Entries: []*Entry{},
+	}
+}
+
+func (c *Catalog) Append(entry *Entry) {
+	c.Entries = append(c.Entries, entry)
+}
+
+// Supports reports whether the section is the catalog section.
+func (c *Catalog) Supports(section string) bool {
+	return section == CatalogSection
+}
+
+// RenderDirective returns the markdown of the catalog, where the directive attributes are ignored.
+func (c *Catalog) RenderDirective(_ *util.Directive) (string, error) {
+	return c.ToMarkdown(), nil
+}
+
+func (c *Catalog) ToMarkdown() string {
+	sort.SliceStable(c.Entries, func(i, j int) bool {
+		return c.Entries[i].Path < c.Entries[j].Path
+	})
+
+	var sb strings.Builder
+	if len(c.Entries) != 0 {
+		sb.WriteString(util.TableHeader(Columns))
+		sb.WriteString("\n")
+		sb.WriteString(util.TableColumnSeparator(Columns))
+		sb.WriteString("\n")
+		for _, entry := range c.Entries {
+			sb.WriteString(util.TableRow(Columns, entry))
+			sb.WriteString("\n")
+		}
+	} else {
+		sb.WriteString(util.UpperNAString)
+	}
+	return strings.TrimSpace(sb.String())
+}
+
+// Entry is a row of the catalog. Secrets is nil for Custom Actions.
+type Entry struct {
+	Name        *util.NullString
+	Path        string
+	Description *util.NullString
+	Inputs      int
+	Outputs     int
+	Secrets     *int
+}
+
+func NewActionEntry(path string, yaml []byte) (*Entry, error) {
+	document, err := action.NewParser(conf.DefaultSortConfig()).Parse(yaml)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Entry{
+		Name:        document.Name,
+		Path:        path,
+		Description: document.Description,
+		Inputs:      len(document.Inputs),
+		Outputs:     len(document.Outputs),
+		Secrets:     nil,
+	}, nil
+}
+
+func NewWorkflowEntry(path string, yaml []byte) (*Entry, error) {
+	document, err := workflow.NewParser(conf.DefaultSortConfig()).Parse(yaml)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := len(document.Secrets)
+	return &Entry{
+		Name:        document.Name,
+		Path:        path,
+		Description: util.DefaultNullString,
+		Inputs:      len(document.Inputs),
+		Outputs:     len(document.Outputs),
+		Secrets:     &secrets,
+	}, nil
+}
+
+// name returns the name, or the directory name for action.yml and the file name without the extension for workflows.
+func (e *Entry) name() string {
+	if e.Name.IsValid() {
+		return e.Name.Value
+	}
+
+	base := path.Base(e.Path)
+	if base == "action.yml" || base == "action.yaml" {
+		return path.Base(path.Dir(e.Path))
+	}
+	return strings.TrimSuffix(base, path.Ext(base))
+}
+
+func (e *Entry) secrets() string {
+	if e.Secrets == nil {
+		return util.LowerNAString
+	}
+	return fmt.Sprintf("%d", *e.Secrets)
+}
+
+var Columns = []*util.Column[*Entry]{
+	{Key: "name", Title: "Name", Value: func(e *Entry) string { return util.EscapeMarkdownCell(e.name()) }},
+	{Key: "path", Title: "Path", Value: func(e *Entry) string { return util.MarkdownLink(e.Path, e.Path) }},
+	{Key: "description", Title: "Description", Value: func(e *Entry) string { return e.Description.StringOrEmpty() }},
+	{Key: "inputs", Title: "Inputs", Align: util.AlignRight, Value: func(e *Entry) string { return fmt.Sprintf("%d", e.Inputs) }},
+	{Key: "outputs", Title: "Outputs", Align: util.AlignRight, Value: func(e *Entry) string { return fmt.Sprintf("%d", e.Outputs) }},
+	{Key: "secrets", Title: "Secrets", Align: util.AlignRight, Value: func(e *Entry) string { return e.secrets() }},
+}
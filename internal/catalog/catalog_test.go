@@ -0,0 +1,52 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCatalog_ToMarkdown(t *testing.T) {
+	secrets := 2
+	cases := []struct {
+		name     string
+		sut      *Catalog
+		expected string
+	}{
+		{
+			name:     "empty",
+			sut:      NewCatalog(),
+			expected: "N/A",
+		},
+		{
+			name: "full",
+			sut: &Catalog{
+				Entries: []*Entry{
+					{Name: NewNullValue(), Path: "actions/setup/action.yml", Description: NewNullValue(), Inputs: 0, Outputs: 0, Secrets: nil},
+					{Name: NewNotNullValue("Lint"), Path: ".github/workflows/lint.yml", Description: NewNullValue(), Inputs: 1, Outputs: 0, Secrets: &secrets},
+					{Name: NewNotNullValue("Build"), Path: "actions/build/action.yml", Description: NewNotNullValue("Build the app."), Inputs: 3, Outputs: 1, Secrets: nil},
+					{Name: NewNullValue(), Path: ".github/workflows/release.yaml", Description: NewNullValue(), Inputs: 0, Outputs: 0, Secrets: &secrets},
+					{Name: NewNullValue(), Path: "actions/deploy/action.yaml", Description: NewNullValue(), Inputs: 0, Outputs: 0, Secrets: nil},
+					{Name: NewNotNullValue("Test | <Unit>"), Path: "actions/unit test/action.yml", Description: NewNullValue(), Inputs: 0, Outputs: 0, Secrets: nil},
+				},
+			},
+			expected: fullCatalogExpected,
+		},
+	}
+
+	for _, tc := range cases {
+		got := tc.sut.ToMarkdown()
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("%s: diff: %s", tc.name, diff)
+		}
+	}
+}
+
+const fullCatalogExpected = `| Name | Path | Description | Inputs | Outputs | Secrets |
+| :--- | :--- | :---------- | -----: | ------: | ------: |
+| Lint | [.github/workflows/lint.yml](.github/workflows/lint.yml) |  | 1 | 0 | 2 |
+| release | [.github/workflows/release.yaml](.github/workflows/release.yaml) |  | 0 | 0 | 2 |
+| Build | [actions/build/action.yml](actions/build/action.yml) | Build the app. | 3 | 1 | n/a |
+| deploy | [actions/deploy/action.yaml](actions/deploy/action.yaml) |  | 0 | 0 | n/a |
+| setup | [actions/setup/action.yml](actions/setup/action.yml) |  | 0 | 0 | n/a |
+| Test \| &lt;Unit&gt; | [actions/unit test/action.yml](actions/unit%20test/action.yml) |  | 0 | 0 | n/a |`
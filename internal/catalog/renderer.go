@@ -0,0 +1,24 @@
+package catalog
+
+import (
+	"io"
+
+	"github.com/tmknom/actdocs/internal/util"
+)
+
+type Renderer struct {
+	template io.Reader
+}
+
+func NewRenderer(template io.Reader) *Renderer {
+	return &Renderer{
+		template: template,
+	}
+}
+
+func (r *Renderer) Render(catalog *Catalog) (string, error) {
+	resolver := func(source string) (util.Document, error) { return catalog, nil }
+	return util.NewInjector(r.template, []string{CatalogSection}, resolver).Inject()
+}
+
+const CatalogSection = "catalog"
@@ -9,6 +9,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/tmknom/actdocs/internal/conf"
+	"github.com/tmknom/actdocs/internal/model"
 )
 
 // AppName is the cli name (set by main.go)
@@ -19,7 +20,8 @@ var AppVersion string
 
 type App struct {
 	*IO
-	debug bool
+	debug      bool
+	configFile string
 }
 
 func NewApp(name string, version string, commit string, date string) *App {
@@ -49,23 +51,88 @@ func (a *App) Run(args []string, inReader io.Reader, outWriter, errWriter io.Wri
 	// setup global flags
 	formatterConfig := conf.DefaultFormatterConfig()
 	sortConfig := conf.DefaultSortConfig()
-	rootCmd.PersistentFlags().StringVar(&formatterConfig.Format, "format", conf.DefaultFormat, "output format [markdown json]")
-	rootCmd.PersistentFlags().BoolVar(&formatterConfig.Omit, "omit", conf.DefaultOmit, "omit for markdown if item not exists")
-	rootCmd.PersistentFlags().BoolVarP(&sortConfig.Sort, "sort", "s", conf.DefaultSort, "sort items by name and required")
-	rootCmd.PersistentFlags().BoolVar(&sortConfig.SortByName, "sort-by-name", conf.DefaultSortByName, "sort items by name")
-	rootCmd.PersistentFlags().BoolVar(&sortConfig.SortByRequired, "sort-by-required", conf.DefaultSortByRequired, "sort items by required")
+	rootCmd.PersistentFlags().StringVar(&formatterConfig.Format, conf.FormatKey, conf.DefaultFormat, fmt.Sprintf("output format [%s]", strings.Join(model.Formatters.Names(), " ")))
+	rootCmd.PersistentFlags().BoolVar(&formatterConfig.Omit, conf.OmitKey, conf.DefaultOmit, "omit for markdown if item not exists")
+	rootCmd.PersistentFlags().StringSliceVar(&formatterConfig.Sections, conf.SectionsKey, []string{}, "sections to render in order for markdown [description inputs secrets outputs permissions] (default all)")
+	rootCmd.PersistentFlags().StringSliceVar(&formatterConfig.OmitSections, conf.OmitSectionsKey, []string{}, "sections to omit for markdown if item not exists")
+	rootCmd.PersistentFlags().IntVar(&formatterConfig.HeadingOffset, conf.HeadingOffsetKey, conf.DefaultHeadingOffset, "offset added to the heading level of sections for markdown")
+	rootCmd.PersistentFlags().StringToStringVar(&formatterConfig.Titles, conf.TitlesKey, map[string]string{}, "section titles for markdown (e.g. inputs=Parameters)")
+	rootCmd.PersistentFlags().StringVar(&formatterConfig.Layout, conf.LayoutKey, conf.DefaultLayout, fmt.Sprintf("layout of the items for markdown [%s]", strings.Join(conf.AllLayouts, " ")))
+	rootCmd.PersistentFlags().StringToStringVar(&formatterConfig.Layouts, conf.LayoutsKey, map[string]string{}, "layouts of the items by section for markdown (e.g. inputs=list)")
+	rootCmd.PersistentFlags().BoolVar(&formatterConfig.Page, conf.PageKey, conf.DefaultPage, "render a full page instead of a fragment for html")
+	columns := map[string]*[]string{}
+	for _, section := range []string{conf.InputsSection, conf.SecretsSection, conf.OutputsSection, conf.PermissionsSection} {
+		columns[section] = rootCmd.PersistentFlags().StringSlice(conf.ColumnsKey(section), []string{}, fmt.Sprintf("%s table columns in order for markdown %v", section, conf.AllColumns[section]))
+	}
+	rootCmd.PersistentFlags().BoolVarP(&sortConfig.Sort, conf.SortKey, "s", conf.DefaultSort, "sort items by name and required")
+	rootCmd.PersistentFlags().BoolVar(&sortConfig.SortByName, conf.SortByNameKey, conf.DefaultSortByName, "sort items by name")
+	rootCmd.PersistentFlags().BoolVar(&sortConfig.SortByRequired, conf.SortByRequiredKey, conf.DefaultSortByRequired, "sort items by required")
+
+	// setup config file
+	fileConfig := conf.NewFileConfig()
+	rootCmd.PersistentFlags().StringVar(&a.configFile, "config", "", "config file path (default: .actdocs.yml searched from the working directory upward)")
+	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		for section, keys := range columns {
+			if cmd.Flags().Changed(conf.ColumnsKey(section)) {
+				formatterConfig.SetColumns(section, *keys)
+			}
+		}
+		if err := a.loadConfig(cmd, fileConfig, formatterConfig, sortConfig); err != nil {
+			return err
+		}
+		if _, err := model.Formatters.Lookup(formatterConfig.Format); err != nil {
+			return err
+		}
+		return formatterConfig.Validate()
+	}
 
 	// setup version option
 	version := fmt.Sprintf("%s version %s", AppName, AppVersion)
 	rootCmd.SetVersionTemplate(version)
 
 	// setup commands
-	rootCmd.AddCommand(NewGenerateCommand(formatterConfig, sortConfig, a.IO))
-	rootCmd.AddCommand(NewInjectCommand(formatterConfig, sortConfig, a.IO))
+	rootCmd.AddCommand(NewGenerateCommand(formatterConfig, sortConfig, fileConfig, a.IO))
+	rootCmd.AddCommand(NewInjectCommand(formatterConfig, sortConfig, fileConfig, a.IO))
+	rootCmd.AddCommand(NewCatalogCommand(a.IO))
+	rootCmd.AddCommand(NewConfigCommand(&a.configFile, a.IO))
 
 	return rootCmd.Execute()
 }
 
+func (a *App) loadConfig(cmd *cobra.Command, fileConfig *conf.FileConfig, formatter *conf.FormatterConfig, sort *conf.SortConfig) error {
+	path := a.configFile
+	if path == "" {
+		found, err := conf.FindFileConfig(".")
+		if err != nil {
+			return err
+		}
+		path = found
+	}
+	if path == "" {
+		log.Printf("config: not found")
+		return nil
+	}
+
+	loaded, err := conf.LoadFileConfig(path)
+	if err != nil {
+		return err
+	}
+	log.Printf("config: %s", path)
+	*fileConfig = *loaded
+
+	names := []string{conf.FormatKey, conf.OmitKey, conf.SectionsKey, conf.OmitSectionsKey, conf.HeadingOffsetKey, conf.TitlesKey, conf.PageKey, conf.LayoutKey, conf.LayoutsKey, conf.SortKey, conf.SortByNameKey, conf.SortByRequiredKey}
+	for section := range conf.AllColumns {
+		names = append(names, conf.ColumnsKey(section))
+	}
+	for _, name := range names {
+		if cmd.Flags().Changed(name) {
+			fileConfig.SetExplicit(name)
+		}
+	}
+	fileConfig.ApplyDefaults(formatter, sort)
+	return nil
+}
+
 func (a *App) setupLog(args []string) {
 	log.SetOutput(io.Discard)
 	if a.isDebug() || a.debug {
@@ -98,8 +165,3 @@ func NewIO(inReader io.Reader, outWriter, errWriter io.Writer) *IO {
 		ErrWriter: errWriter,
 	}
 }
-
-const (
-	ActionRegex   = `(?m)^[\s]*runs:`
-	WorkflowRegex = `(?m)^[\s]*workflow_call:`
-)
@@ -2,7 +2,9 @@ package cli
 
 import (
 	"bytes"
+	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -76,6 +78,74 @@ func TestAppRunWithGenerate(t *testing.T) {
 			args:     []string{"generate", "--format=json", testBaseDir + "testdata/valid-empty-action.yml"},
 			expected: expectedGenerateWithEmptyFormatJsonAction,
 		},
+		{
+			args:     []string{"generate", "--sort", "--sections=inputs,outputs", testBaseDir + "testdata/nasty-action.yml"},
+			expected: expectedGenerateWithNastyAction,
+		},
+		{
+			args:     []string{"generate", "--sort", "--layout=list", "--sections=outputs", testBaseDir + "testdata/valid-action.yml"},
+			expected: expectedGenerateWithListLayoutAction,
+		},
+		{
+			args:     []string{"generate", "--format=yaml", testBaseDir + "testdata/valid-empty-action.yml"},
+			expected: "description: null\ninputs: []\noutputs: []\n",
+		},
+		{
+			args:     []string{"generate", "--format=asciidoc", testBaseDir + "testdata/valid-empty-action.yml"},
+			expected: expectedGenerateWithEmptyFormatAsciidocAction,
+		},
+		{
+			args:     []string{"generate", "--format=html", "--page", "--sections=inputs", testBaseDir + "testdata/valid-empty-action.yml"},
+			expected: expectedGenerateWithPageFormatHtmlAction,
+		},
+		{
+			args:     []string{"generate", "--sort", testBaseDir + "testdata/valid-empty-action.yml", testBaseDir + "testdata/valid-empty-workflow.yml"},
+			expected: expectedGenerateWithEmptyAction + "\n" + expectedGenerateWithEmptyWorkflow,
+		},
+		{
+			args:     []string{"generate", "--sort", testBaseDir + "testdata/valid-empty-*.yml"},
+			expected: expectedGenerateWithEmptyAction + "\n" + expectedGenerateWithEmptyWorkflow,
+		},
+		{
+			args:     []string{"generate", "--format=json", testBaseDir + "testdata/valid-empty-action.yml", testBaseDir + "testdata/valid-empty-workflow.yml"},
+			expected: expectedGenerateWithMultipleJson,
+		},
+		{
+			args:     []string{"generate", "--format=yaml", testBaseDir + "testdata/valid-empty-action.yml", testBaseDir + "testdata/valid-empty-workflow.yml"},
+			expected: expectedGenerateWithMultipleYaml,
+		},
+		{
+			args:     []string{"generate", "--sort", testBaseDir + "testdata/discovery"},
+			expected: expectedGenerateWithEmptyWorkflow + "\n" + expectedGenerateWithEmptyAction,
+		},
+		{
+			args:     []string{"generate", "--config=" + testBaseDir + "testdata/config/.actdocs.yml", testBaseDir + "testdata/valid-workflow.yml"},
+			expected: expectedGenerateWithSortWorkflow,
+		},
+		{
+			args:     []string{"generate", "--config=" + testBaseDir + "testdata/config/.actdocs.yml", testBaseDir + "testdata/config/omitted/action.yml"},
+			expected: expectedGenerateWithOmitAction,
+		},
+		{
+			args:     []string{"generate", "--config=" + testBaseDir + "testdata/config/.actdocs.yml", "--omit=false", testBaseDir + "testdata/config/omitted/action.yml"},
+			expected: expectedGenerateWithEmptyAction,
+		},
+		{
+			args:     []string{"generate", "--sections=outputs,inputs", "--omit-sections=inputs", testBaseDir + "testdata/valid-empty-action.yml"},
+			expected: "## Outputs\n\nN/A\n",
+		},
+		{
+			args:     []string{"generate", "--heading-offset=1", "--titles=inputs=Parameters,outputs=Results", testBaseDir + "testdata/valid-empty-action.yml"},
+			expected: "### Description\n\nN/A\n\n### Parameters\n\nN/A\n\n### Results\n\nN/A\n",
+		},
+		{
+			args:     []string{"generate", "--sort", "--sections=outputs", "--outputs-columns=description,name", testBaseDir + "testdata/valid-workflow.yml"},
+			expected: "## Outputs\n\n| Description | Name |\n| :---------- | :--- |\n|  | only-value |\n| The description value. | with-description |\n",
+		},
+		{
+			args:     []string{"config", "validate", testBaseDir + "testdata/config/.actdocs.yml"},
+			expected: "../../testdata/config/.actdocs.yml: valid\n",
+		},
 	}
 
 	app := NewApp("test", "", "", "")
@@ -98,7 +168,7 @@ const expectedGenerateWithSortWorkflow = `## Inputs
 
 | Name | Description | Type | Default | Required |
 | :--- | :---------- | :--- | :------ | :------: |
-| full-string | The full string value. | ` + "`string`" + ` | ` + "``" + ` | yes |
+| full-string | The full string value. | ` + "`string`" + ` | ` + "`\"\"`" + ` | yes |
 | required-and-description | The required and description value. | n/a | n/a | yes |
 | default-and-type |  | ` + "`string`" + ` | ` + "`foo`" + ` | no |
 | empty |  | n/a | n/a | no |
@@ -176,7 +246,7 @@ const expectedGenerateWithSortByNameWorkflow = `## Inputs
 | empty |  | n/a | n/a | no |
 | full-boolean | The full boolean value. | ` + "`boolean`" + ` | ` + "`true`" + ` | no |
 | full-number | The full number value. | ` + "`number`" + ` | ` + "`5`" + ` | no |
-| full-string | The full string value. | ` + "`string`" + ` | ` + "``" + ` | yes |
+| full-string | The full string value. | ` + "`string`" + ` | ` + "`\"\"`" + ` | yes |
 | required-and-description | The required and description value. | n/a | n/a | yes |
 
 ## Secrets
@@ -420,6 +490,158 @@ const expectedGenerateWithEmptyFormatJsonAction = `{
 }
 `
 
+const expectedGenerateWithNastyAction = `## Inputs
+
+| Name | Description | Default | Required |
+| :--- | :---------- | :------ | :------: |
+| a-pipe | Choose foo \| bar. | ` + "`foo\\|bar`" + ` | yes |
+| b-backtick | Use ` + "`a<b`" + ` or ` + "``a`b``" + `. | ` + "``a`b``" + ` | no |
+| c-html | &lt;script&gt;alert(1)&lt;/script&gt; | ` + "`<b>`" + ` | no |
+| d-whitespace | padded | ` + "`  padded  `" + ` | no |
+| e-multi-line | <pre>First \| line.<br>&lt;Second&gt; line.</pre> | <pre>&#96;&#96;&#96;<br>code<br>&#96;&#96;&#96;</pre> | no |
+
+## Outputs
+
+| Name | Description |
+| :--- | :---------- |
+| result | The ` + "`\\|`" + ` separated result. |
+`
+
+const expectedGenerateWithListLayoutAction = `## Outputs
+
+### only-value
+
+### with-description
+
+The output value with description.
+`
+
+const expectedGenerateWithEmptyFormatAsciidocAction = `== Description
+
+N/A
+
+== Inputs
+
+N/A
+
+== Outputs
+
+N/A
+`
+
+const expectedGenerateWithPageFormatHtmlAction = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Valid Empty Action</title>
+</head>
+<body>
+<h1>Valid Empty Action</h1>
+<h2 id="inputs">Inputs</h2>
+<p>N/A</p>
+</body>
+</html>
+`
+
+const expectedGenerateWithMultipleJson = `[
+  {
+    "description": null,
+    "inputs": [],
+    "outputs": []
+  },
+  {
+    "inputs": [],
+    "secrets": [],
+    "outputs": [],
+    "permissions": []
+  }
+]
+`
+
+const expectedGenerateWithMultipleYaml = `description: null
+inputs: []
+outputs: []
+---
+inputs: []
+secrets: []
+outputs: []
+permissions: []
+`
+
+func TestAppRunWithGenerateOutput(t *testing.T) {
+	dest := filepath.Join(t.TempDir(), "inputs.md")
+	source := testBaseDir + "testdata/valid-empty-action.yml"
+	cases := []struct {
+		args     []string
+		expected string
+	}{
+		{
+			args:     []string{"generate", "--check", "--output=" + dest, source},
+			expected: dest + ": out of date: run generate with --output to update",
+		},
+		{
+			args:     []string{"generate", "--output=" + dest, source},
+			expected: "updated: " + dest + "\n",
+		},
+		{
+			args:     []string{"generate", "--output=" + dest, source},
+			expected: "unchanged: " + dest + "\n",
+		},
+		{
+			args:     []string{"generate", "--check", "--output=" + dest, source},
+			expected: "unchanged: " + dest + "\n",
+		},
+		{
+			args:     []string{"generate", "--check", "--omit", "--output=" + dest, source},
+			expected: dest + ": out of date: run generate with --output to update",
+		},
+		{
+			args:     []string{"generate", "--check", source},
+			expected: "--check requires --output",
+		},
+	}
+
+	for _, tc := range cases {
+		outWriter := &bytes.Buffer{}
+		app := NewApp("test", "", "", "")
+		err := app.Run(tc.args, os.Stdin, outWriter, &bytes.Buffer{})
+
+		got := outWriter.String()
+		if err != nil {
+			got = err.Error()
+		}
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("%s: unexpected out: \n%s", strings.Join(tc.args, " "), diff)
+		}
+	}
+
+	content, err := os.ReadFile(dest)
+	if err != nil || string(content) != expectedGenerateWithEmptyAction {
+		t.Errorf("unexpected content: %q (%v)", content, err)
+	}
+}
+
+func TestAppRunWithGenerateOutputWithoutSort(t *testing.T) {
+	dest := filepath.Join(t.TempDir(), "docs", "README.md")
+	source := testBaseDir + "testdata/valid-workflow.yml"
+
+	args := []string{"generate", "--output=" + dest, source}
+	if err := NewApp("test", "", "", "").Run(args, os.Stdin, &bytes.Buffer{}, &bytes.Buffer{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for i := 0; i < 10; i++ {
+		outWriter := &bytes.Buffer{}
+		args := []string{"generate", "--check", "--output=" + dest, source}
+		if err := NewApp("test", "", "", "").Run(args, os.Stdin, outWriter, &bytes.Buffer{}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if diff := cmp.Diff(outWriter.String(), "unchanged: "+dest+"\n"); diff != "" {
+			t.Errorf("unexpected out: \n%s", diff)
+		}
+	}
+}
+
 func TestAppRunWithInject(t *testing.T) {
 	cases := []struct {
 		args     []string
@@ -449,6 +671,34 @@ func TestAppRunWithInject(t *testing.T) {
 			args:     []string{"inject", "--sort", "--dry-run", "--omit", "--file=" + testBaseDir + "testdata/output.md", testBaseDir + "testdata/valid-empty-action.yml"},
 			expected: expectedInjectWithOmitAction,
 		},
+		{
+			args:     []string{"inject", "--dry-run", "--sections=outputs,inputs", "--omit-sections=outputs", "--file=" + testBaseDir + "testdata/output.md", testBaseDir + "testdata/valid-empty-action.yml"},
+			expected: expectedInjectWithSectionsAction,
+		},
+		{
+			args:     []string{"inject", "--recursive", "--dry-run", testBaseDir + "testdata/recursive/updated", testBaseDir + "testdata/recursive/unchanged"},
+			expected: expectedInjectWithRecursive,
+		},
+		{
+			args:     []string{"inject", "--dry-run", "--file=" + testBaseDir + "testdata/inject-sources.md"},
+			expected: expectedInjectWithSources,
+		},
+		{
+			args:     []string{"inject", "--dry-run", "--create", "--file=" + testBaseDir + "testdata/not-found.md", testBaseDir + "testdata/valid-empty-action.yml"},
+			expected: expectedInjectWithCreate,
+		},
+		{
+			args:     []string{"inject", "--dry-run", "--insert-after=Missing", "--file=" + testBaseDir + "testdata/recursive/missing/README.md", testBaseDir + "testdata/recursive/missing/action.yml"},
+			expected: expectedInjectWithInsertAfter,
+		},
+		{
+			args:     []string{"inject", "--dry-run", "--file=" + testBaseDir + "testdata/output.rst", testBaseDir + "testdata/valid-empty-action.yml"},
+			expected: expectedInjectWithRst,
+		},
+		{
+			args:     []string{"inject", "--dry-run", "--file=" + testBaseDir + "testdata/output.adoc", testBaseDir + "testdata/valid-empty-action.yml"},
+			expected: expectedInjectWithAsciidoc,
+		},
 	}
 
 	app := NewApp("test", "", "", "")
@@ -479,7 +729,7 @@ This is a header.
 
 | Name | Description | Type | Default | Required |
 | :--- | :---------- | :--- | :------ | :------: |
-| full-string | The full string value. | ` + "`string`" + ` | ` + "``" + ` | yes |
+| full-string | The full string value. | ` + "`string`" + ` | ` + "`\"\"`" + ` | yes |
 | required-and-description | The required and description value. | n/a | n/a | yes |
 | default-and-type |  | ` + "`string`" + ` | ` + "`foo`" + ` | no |
 | empty |  | n/a | n/a | no |
@@ -625,6 +875,462 @@ N/A
 This is a footer.
 `
 
+const expectedInjectWithSources = `# Sources test
+
+## Action
+
+<!-- actdocs inputs start source=valid-action.yml sort=name -->
+
+## Inputs
+
+| Name | Description | Default | Required |
+| :--- | :---------- | :------ | :------: |
+| description-only | The description without default and required. | n/a | no |
+| empty |  | n/a | no |
+| full-boolean | The full boolean value. | ` + "`true`" + ` | no |
+| full-number | The full number value. | ` + "`5`" + ` | no |
+| full-string | The full string value. | ` + "`Default value`" + ` | yes |
+
+<!-- actdocs inputs end -->
+
+## Workflow
+
+<!-- actdocs secrets start source=valid-workflow.yml sort=true heading=3 -->
+
+### Secrets
+
+| Name | Description | Required |
+| :--- | :---------- | :------: |
+| alternative-required-secret | The alternative required secret value. | yes |
+| required-secret | The required secret value. | yes |
+| empty |  | no |
+| not-required-secret | The not required secret value. | no |
+| without-required-secret | The not required secret value. | no |
+
+<!-- actdocs secrets end -->
+
+<!-- actdocs outputs start source=valid-workflow.yml sort=true heading=3 -->
+
+### Outputs
+
+| Name | Description |
+| :--- | :---------- |
+| only-value |  |
+| with-description | The description value. |
+
+<!-- actdocs outputs end -->
+`
+
+const expectedInjectWithCreate = `# Valid Empty Action
+
+<!-- actdocs start -->
+
+## Description
+
+N/A
+
+## Inputs
+
+N/A
+
+## Outputs
+
+N/A
+
+<!-- actdocs end -->
+`
+
+const expectedInjectWithInsertAfter = `# Missing
+
+<!-- actdocs start -->
+
+## Description
+
+N/A
+
+## Inputs
+
+N/A
+
+## Outputs
+
+N/A
+
+<!-- actdocs end -->
+
+No markers.
+`
+
+const expectedInjectWithAsciidoc = `= Output test
+
+== Header
+
+This is a header.
+
+// actdocs start
+
+== Description
+
+N/A
+
+== Inputs
+
+N/A
+
+== Outputs
+
+N/A
+
+// actdocs end
+
+== Footer
+
+This is a footer.
+`
+
+const expectedInjectWithRst = `Output test
+===========
+
+Header
+------
+
+This is a header.
+
+.. actdocs start
+
+Description
+-----------
+
+N/A
+
+Inputs
+------
+
+N/A
+
+Outputs
+-------
+
+N/A
+
+.. actdocs end
+
+Footer
+------
+
+This is a footer.
+`
+
+func TestAppRunWithInjectDryRunPercent(t *testing.T) {
+	template := "# 100% done\n\nUse %s and %d as is.\n\n<!-- actdocs inputs start -->\n<!-- actdocs inputs end -->\n"
+	dest := filepath.Join(t.TempDir(), "README.md")
+	if err := os.WriteFile(dest, []byte(template), 0644); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	outWriter := &bytes.Buffer{}
+	args := []string{"inject", "--dry-run", "--file=" + dest, testBaseDir + "testdata/valid-empty-action.yml"}
+	if err := NewApp("test", "", "", "").Run(args, os.Stdin, outWriter, &bytes.Buffer{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := "# 100% done\n\nUse %s and %d as is.\n\n<!-- actdocs inputs start -->\n\n## Inputs\n\nN/A\n\n<!-- actdocs inputs end -->\n"
+	if diff := cmp.Diff(outWriter.String(), expected); diff != "" {
+		t.Errorf("unexpected out: \n%s", diff)
+	}
+}
+
+func TestAppRunWithInjectWriting(t *testing.T) {
+	template, err := os.ReadFile(testBaseDir + "testdata/output.md")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	dest := filepath.Join(t.TempDir(), "README.md")
+	if err = os.WriteFile(dest, template, 0600); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	args := []string{"inject", "--file=" + dest, testBaseDir + "testdata/valid-action.yml"}
+	expected := []string{"updated: " + dest + "\n"}
+	for i := 0; i < 10; i++ {
+		expected = append(expected, "unchanged: "+dest+"\n")
+	}
+	for _, want := range expected {
+		outWriter := &bytes.Buffer{}
+		app := NewApp("test", "", "", "")
+		if err = app.Run(args, os.Stdin, outWriter, &bytes.Buffer{}); err != nil {
+			t.Fatalf("%s: unexpected error: %s", strings.Join(args, " "), err)
+		}
+		if diff := cmp.Diff(outWriter.String(), want); diff != "" {
+			t.Errorf("%s: unexpected out: \n%s", strings.Join(args, " "), diff)
+		}
+	}
+
+	info, err := os.Stat(dest)
+	if err != nil || info.Mode().Perm() != 0600 {
+		t.Errorf("expected the file mode is preserved, but got: %v (%v)", info.Mode().Perm(), err)
+	}
+}
+
+func TestAppRunWithRecursiveConfig(t *testing.T) {
+	action, err := os.ReadFile(testBaseDir + "testdata/recursive/updated/action.yml")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	template := "<!-- actdocs start -->\n<!-- actdocs end -->\n"
+
+	cases := []struct {
+		name     string
+		config   string
+		expected string
+	}{
+		{
+			name:     "override",
+			config:   "overrides:\n  - path: foo\n    file: foo/docs/README.md\n",
+			expected: "updated: {dir}/bar/README.md\nupdated: {dir}/foo/docs/README.md\nSummary: 2 updated, 0 unchanged, 0 failed\n",
+		},
+		{
+			name:     "shared",
+			config:   "file: README.md\n",
+			expected: "failed: {dir}/README.md: {dir}/bar/action.yml: shared with 2 actions: use the source attribute instead\nfailed: {dir}/README.md: {dir}/foo/action.yml: shared with 2 actions: use the source attribute instead\nSummary: 0 updated, 0 unchanged, 2 failed\n",
+		},
+	}
+
+	for _, tc := range cases {
+		dir := t.TempDir()
+		files := map[string]string{
+			".actdocs.yml":       tc.config,
+			"README.md":          template,
+			"foo/action.yml":     string(action),
+			"foo/docs/README.md": template,
+			"bar/action.yml":     string(action),
+			"bar/README.md":      template,
+		}
+		for name, content := range files {
+			path := filepath.Join(dir, name)
+			if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatalf("%s: unexpected error: %s", tc.name, err)
+			}
+			if err = os.WriteFile(path, []byte(content), 0644); err != nil {
+				t.Fatalf("%s: unexpected error: %s", tc.name, err)
+			}
+		}
+
+		outWriter := &bytes.Buffer{}
+		args := []string{"inject", "--recursive", "--config=" + filepath.Join(dir, ".actdocs.yml"), dir}
+		_ = NewApp("test", "", "", "").Run(args, os.Stdin, outWriter, &bytes.Buffer{})
+
+		got, _, _ := strings.Cut(outWriter.String(), "Usage:")
+		expected := strings.ReplaceAll(tc.expected, "{dir}", dir)
+		if diff := cmp.Diff(got, expected); diff != "" {
+			t.Errorf("%s: unexpected out: \n%s", tc.name, diff)
+		}
+	}
+}
+
+func TestAppRunWithGenerateMixedFormats(t *testing.T) {
+	action, err := os.ReadFile(testBaseDir + "testdata/valid-empty-action.yml")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	dir := t.TempDir()
+	files := map[string]string{
+		".actdocs.yml":   "overrides:\n  - path: foo\n    format: json\n",
+		"foo/action.yml": string(action),
+		"bar/action.yml": string(action),
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if err = os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	bar := filepath.Join(dir, "bar/action.yml")
+	foo := filepath.Join(dir, "foo/action.yml")
+	args := []string{"generate", "--config=" + filepath.Join(dir, ".actdocs.yml"), bar, foo}
+	err = NewApp("test", "", "", "").Run(args, os.Stdin, &bytes.Buffer{}, &bytes.Buffer{})
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+
+	expected := fmt.Sprintf("mixed formats in one output: %s is markdown, but %s is json", bar, foo)
+	if diff := cmp.Diff(err.Error(), expected); diff != "" {
+		t.Errorf("unexpected error: \n%s", diff)
+	}
+}
+
+func TestAppRunWithRecursiveConcurrency(t *testing.T) {
+	action, err := os.ReadFile(testBaseDir + "testdata/valid-action.yml")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	template := "<!-- actdocs start -->\n<!-- actdocs end -->\n\n<!-- actdocs start source=../shared/action.yml -->\n<!-- actdocs end -->\n"
+
+	dir := t.TempDir()
+	files := map[string]string{"shared/action.yml": string(action), "shared/README.md": template}
+	for i := 0; i < 8; i++ {
+		files[fmt.Sprintf("action%d/action.yml", i)] = string(action)
+		files[fmt.Sprintf("action%d/README.md", i)] = template
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if err = os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	outWriter := &bytes.Buffer{}
+	args := []string{"inject", "--recursive", "--concurrency=4", dir}
+	if err = NewApp("test", "", "", "").Run(args, os.Stdin, outWriter, &bytes.Buffer{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !strings.HasSuffix(outWriter.String(), "Summary: 9 updated, 0 unchanged, 0 failed\n") {
+		t.Errorf("unexpected out: %s", outWriter.String())
+	}
+}
+
+func TestAppRunWithStdin(t *testing.T) {
+	action, err := os.ReadFile(testBaseDir + "testdata/valid-empty-action.yml")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cases := []struct {
+		args     []string
+		stdin    string
+		expected string
+	}{
+		{
+			args:     []string{"generate", "-"},
+			stdin:    string(action),
+			expected: expectedGenerateWithEmptyAction,
+		},
+		{
+			args:     []string{"generate", "--kind=workflow", "-"},
+			stdin:    "name: Templated\non:\n  workflow_dispatch:\n",
+			expected: expectedGenerateWithEmptyWorkflow,
+		},
+		{
+			args:     []string{"inject", "--dry-run", "--file=" + testBaseDir + "testdata/output.md", "-"},
+			stdin:    string(action),
+			expected: expectedInjectWithEmptyAction,
+		},
+		{
+			args:     []string{"generate", "-"},
+			stdin:    "name: Unknown\n",
+			expected: "-: not found parser: invalid YAML file: specify --kind to read from stdin",
+		},
+		{
+			args:     []string{"generate", "--kind=foo", "-"},
+			stdin:    string(action),
+			expected: "invalid kind: foo, valid values are [action workflow]",
+		},
+	}
+
+	for _, tc := range cases {
+		outWriter := &bytes.Buffer{}
+		app := NewApp("test", "", "", "")
+		err := app.Run(tc.args, strings.NewReader(tc.stdin), outWriter, &bytes.Buffer{})
+
+		got := outWriter.String()
+		if err != nil {
+			got = err.Error()
+		}
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("%s: unexpected out: \n%s", strings.Join(tc.args, " "), diff)
+		}
+	}
+}
+
+func TestAppRunWithError(t *testing.T) {
+	cases := []struct {
+		args     []string
+		expected string
+	}{
+		{
+			args:     []string{"generate", testBaseDir + "testdata/discovery/dependabot.yml"},
+			expected: "../../testdata/discovery/dependabot.yml: not found parser: invalid YAML file",
+		},
+		{
+			args:     []string{"generate", testBaseDir + "testdata/discovery/dependabot.yml", testBaseDir + "testdata/not-found.yml"},
+			expected: "stat ../../testdata/not-found.yml: no such file or directory",
+		},
+		{
+			args:     []string{"generate", testBaseDir + "testdata/not-found.yml", testBaseDir + "testdata/valid-action.yml", testBaseDir + "testdata/not-found/*.yml", testBaseDir + "testdata/not-found-dir"},
+			expected: "stat ../../testdata/not-found.yml: no such file or directory\nno files match the pattern: ../../testdata/not-found/*.yml\nstat ../../testdata/not-found-dir: no such file or directory",
+		},
+		{
+			args:     []string{"inject", "--dry-run", "--insert-after=## Missing", "--file=" + testBaseDir + "testdata/recursive/missing/README.md", testBaseDir + "testdata/valid-action.yml"},
+			expected: "../../testdata/recursive/missing/README.md: not found heading: ## Missing",
+		},
+		{
+			args:     []string{"inject", "--dry-run", "--file=" + testBaseDir + "testdata/output.md", testBaseDir + "testdata/valid-empty-action.yml", testBaseDir + "testdata/valid-empty-workflow.yml"},
+			expected: "--file accepts only one source, but got 2: use the source attribute to inject multiple sources into one file",
+		},
+		{
+			args:     []string{"generate", testBaseDir + "testdata/discovery/dependabot.yml", testBaseDir + "testdata/valid-action.yml", testBaseDir + "testdata/output.md"},
+			expected: "../../testdata/discovery/dependabot.yml: not found parser: invalid YAML file\n../../testdata/output.md: not found parser: invalid YAML file",
+		},
+		{
+			args:     []string{"inject", "--recursive", "--dry-run", testBaseDir + "testdata/recursive/missing"},
+			expected: `../../testdata/recursive/missing/README.md: not found markers: write "<!-- actdocs start -->" and "<!-- actdocs end -->", or use --insert`,
+		},
+		{
+			args:     []string{"inject", "--file=" + testBaseDir + "testdata/inject-unterminated.md", testBaseDir + "testdata/valid-action.yml"},
+			expected: "../../testdata/inject-unterminated.md: line 5: mismatched end marker \"<!-- actdocs outputs end -->\" for marker \"<!-- actdocs inputs start -->\" at line 3\nline 7: unterminated marker \"<!-- actdocs start -->\"",
+		},
+		{
+			args:     []string{"generate", "--format=html", "--page", testBaseDir + "testdata/valid-empty-action.yml", testBaseDir + "testdata/valid-empty-workflow.yml"},
+			expected: "--page accepts only one source, but got 2: generate each page separately",
+		},
+		{
+			args:     []string{"generate", "--sections=inputs,foo", testBaseDir + "testdata/valid-empty-action.yml"},
+			expected: "unknown section: foo, valid values are [description inputs secrets outputs permissions]",
+		},
+		{
+			args:     []string{"generate", "--inputs-columns=name,foo", testBaseDir + "testdata/valid-empty-action.yml"},
+			expected: "unknown inputs column: foo, valid values are [name description type default required deprecated]",
+		},
+		{
+			args:     []string{"generate", "--format=foo", testBaseDir + "testdata/valid-empty-action.yml"},
+			expected: "invalid format: foo, valid values are [markdown json yaml asciidoc html rst]",
+		},
+		{
+			args:     []string{"generate", "--layouts=inputs=grid", testBaseDir + "testdata/valid-empty-action.yml"},
+			expected: "invalid layout: grid, valid values are [table list]",
+		},
+		{
+			args:     []string{"config", "validate", testBaseDir + "testdata/config/invalid.yml"},
+			expected: "../../testdata/config/invalid.yml: invalid config:\n  line 2: unknown key: unknown\n  line 5: unknown key: omitt",
+		},
+		{
+			args:     []string{"config", "validate", testBaseDir + "testdata/config/invalid-format.yml"},
+			expected: "../../testdata/config/invalid-format.yml: overrides sub: invalid format: bogus, valid values are [markdown json yaml asciidoc html rst]",
+		},
+	}
+
+	app := NewApp("test", "", "", "")
+	for _, tc := range cases {
+		inOut := NewIO(os.Stdin, &bytes.Buffer{}, &bytes.Buffer{})
+		err := app.Run(tc.args, inOut.InReader, inOut.OutWriter, inOut.ErrWriter)
+		if err == nil {
+			t.Fatalf("%s: expected error", strings.Join(tc.args, " "))
+		}
+
+		if diff := cmp.Diff(err.Error(), tc.expected); diff != "" {
+			t.Errorf("%s: unexpected error: \n%s", strings.Join(tc.args, " "), diff)
+		}
+	}
+}
+
 const expectedInjectWithOmitAction = `# Output test
 
 ## Header
@@ -638,3 +1344,67 @@ This is a header.
 
 This is a footer.
 `
+
+const expectedInjectWithSectionsAction = `# Output test
+
+## Header
+
+This is a header.
+
+<!-- actdocs start -->
+
+## Inputs
+
+N/A
+
+<!-- actdocs end -->
+
+## Footer
+
+This is a footer.
+`
+
+const expectedInjectWithRecursive = `updated: ../../testdata/recursive/updated/README.md
+unchanged: ../../testdata/recursive/unchanged/README.md
+Summary: 1 updated, 1 unchanged, 0 failed
+`
+
+func TestAppRunWithCatalog(t *testing.T) {
+	cases := []struct {
+		args     []string
+		expected string
+	}{
+		{
+			args:     []string{"catalog", "--dry-run", "--file=" + testBaseDir + "testdata/catalog.md", testBaseDir + "testdata/discovery"},
+			expected: expectedCatalog,
+		},
+	}
+
+	app := NewApp("test", "", "", "")
+	for _, tc := range cases {
+		outWriter := &bytes.Buffer{}
+		inOut := NewIO(os.Stdin, outWriter, os.Stderr)
+		err := app.Run(tc.args, inOut.InReader, inOut.OutWriter, inOut.ErrWriter)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", strings.Join(tc.args, " "), err)
+		}
+
+		if diff := cmp.Diff(outWriter.String(), tc.expected); diff != "" {
+			t.Errorf("%s: unexpected out: \n%s", strings.Join(tc.args, " "), diff)
+		}
+	}
+}
+
+const expectedCatalog = `# Catalog test
+
+<!-- actdocs catalog start -->
+
+| Name | Path | Description | Inputs | Outputs | Secrets |
+| :--- | :--- | :---------- | -----: | ------: | ------: |
+| Valid Empty Workflow | [discovery/.github/workflows/reusable.yml](discovery/.github/workflows/reusable.yml) |  | 0 | 0 | 0 |
+| Valid Empty Action | [discovery/action.yml](discovery/action.yml) |  | 0 | 0 | n/a |
+
+<!-- actdocs catalog end -->
+
+## Footer
+`
@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"path/filepath"
+	"sync"
+
+	"github.com/tmknom/actdocs/internal/action"
+	"github.com/tmknom/actdocs/internal/conf"
+	"github.com/tmknom/actdocs/internal/model"
+	"github.com/tmknom/actdocs/internal/util"
+	"github.com/tmknom/actdocs/internal/workflow"
+)
+
+// SpecCache parses each source only once, even if many directives refer to the same source.
+// Different sources are parsed concurrently, since the lock is held only while accessing the entries.
+type SpecCache struct {
+	*conf.FormatterConfig
+	*conf.SortConfig
+	FileConfig *conf.FileConfig
+	*SourceReader
+	specs map[string]*specEntry
+	mutex sync.Mutex
+}
+
+// specEntry is the result of parsing the source, which is parsed once by the first caller.
+type specEntry struct {
+	once sync.Once
+	spec *model.Spec
+	err  error
+}
+
+func NewSpecCache(formatter *conf.FormatterConfig, sort *conf.SortConfig, fileConfig *conf.FileConfig, reader *SourceReader) *SpecCache {
+	return &SpecCache{
+		FormatterConfig: formatter,
+		SortConfig:      sort,
+		FileConfig:      fileConfig,
+		SourceReader:    reader,
+		specs:           map[string]*specEntry{},
+	}
+}
+
+// Get returns the spec of the source, parsing it at the first call.
+// The callers of the same source wait for the first call, and share its result including the error.
+func (c *SpecCache) Get(path string) (*model.Spec, error) {
+	path = filepath.Clean(path)
+	c.mutex.Lock()
+	entry, ok := c.specs[path]
+	if !ok {
+		entry = &specEntry{}
+		c.specs[path] = entry
+	}
+	c.mutex.Unlock()
+
+	entry.once.Do(func() {
+		entry.spec, entry.err = c.parse(path)
+	})
+	return entry.spec, entry.err
+}
+
+func (c *SpecCache) parse(path string) (*model.Spec, error) {
+	log.Printf("parse: %s", path)
+	yaml, err := c.Read(path)
+	if err != nil {
+		return nil, err
+	}
+
+	kind, err := c.KindOf(path, yaml)
+	if err != nil {
+		return nil, err
+	}
+
+	formatter, sort := c.FileConfig.Resolve(path, c.FormatterConfig, c.SortConfig)
+	return ParseSpec(yaml, kind, formatter, sort)
+}
+
+// Resolver returns the resolver for the template in the directory,
+// where the source attribute is relative to the directory, and defaultSource is used without the attribute.
+func (c *SpecCache) Resolver(defaultSource string, dir string) util.DocumentResolver {
+	return func(source string) (util.Document, error) {
+		if source != "" {
+			path := filepath.Join(dir, filepath.FromSlash(source))
+			spec, err := c.Get(path)
+			if err != nil {
+				return nil, fmt.Errorf("%s=%s: %w", util.SourceAttribute, source, err)
+			}
+			return spec, nil
+		}
+
+		if defaultSource == "" {
+			return nil, fmt.Errorf("not found source: specify the source file or %s attribute", util.SourceAttribute)
+		}
+		return c.Get(defaultSource)
+	}
+}
+
+func ParseSpec(yaml []byte, kind string, formatter *conf.FormatterConfig, sort *conf.SortConfig) (*model.Spec, error) {
+	document, err := ParseDocument(yaml, kind, conf.DefaultSortConfig())
+	if err != nil {
+		return nil, err
+	}
+	return model.NewSortedSpec(document, sort, formatter), nil
+}
+
+// ParseDocument parses the YAML with the parser of the kind.
+func ParseDocument(yaml []byte, kind string, sort *conf.SortConfig) (*model.Document, error) {
+	switch kind {
+	case ActionKind:
+		return action.NewParser(sort).Parse(yaml)
+	case WorkflowKind:
+		return workflow.NewParser(sort).Parse(yaml)
+	}
+	return nil, ErrInvalidSource
+}
+
+var ErrInvalidSource = errors.New("not found parser: invalid YAML file")
@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/tmknom/actdocs/internal/catalog"
+)
+
+func NewCatalogCommand(io *IO) *cobra.Command {
+	option := &CatalogOption{IO: io}
+	command := &cobra.Command{
+		Use:   "catalog",
+		Short: "Inject catalog of Custom Actions and Reusable Workflows to existing file",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			log.SetPrefix(fmt.Sprintf("[%s] [%s] ", AppName, cmd.Name()))
+			log.Printf("start: command = %s, option = %#v", cmd.Name(), option)
+			if option.OutputFile == "" {
+				return cmd.Usage()
+			}
+			if len(args) == 0 {
+				args = []string{"."}
+			}
+			runner := NewCatalogRunner(args, option)
+			return runner.Run()
+		},
+	}
+
+	command.PersistentFlags().StringVarP(&option.OutputFile, "file", "f", "", "file path to insert catalog into (default \"\")")
+	command.PersistentFlags().BoolVar(&option.DryRun, "dry-run", false, "dry run")
+	return command
+}
+
+type CatalogRunner struct {
+	sources []string
+	*CatalogOption
+}
+
+func NewCatalogRunner(sources []string, option *CatalogOption) *CatalogRunner {
+	return &CatalogRunner{
+		sources:       sources,
+		CatalogOption: option,
+	}
+}
+
+type CatalogOption struct {
+	OutputFile string
+	DryRun     bool
+	*IO
+}
+
+func (r *CatalogRunner) Run() error {
+	sources, err := ExpandSources(r.sources)
+	if err != nil {
+		return err
+	}
+
+	result := catalog.NewCatalog()
+	var errs []error
+	for _, source := range sources {
+		entry, err := r.parse(source)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", source.Path, err))
+		} else if entry != nil {
+			result.Append(entry)
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	dest, err := os.Open(r.OutputFile)
+	if err != nil {
+		return err
+	}
+	defer func(file *os.File) { err = file.Close() }(dest)
+
+	rendered, err := catalog.NewRenderer(dest).Render(result)
+	if err != nil {
+		return err
+	}
+	if r.DryRun {
+		_, err = fmt.Fprint(r.OutWriter, rendered)
+		return err
+	}
+	_, err = WriteFile(r.OutputFile, rendered)
+	return err
+}
+
+// parse returns nil if the source is neither Custom Action nor Reusable Workflow.
+func (r *CatalogRunner) parse(source *Source) (*catalog.Entry, error) {
+	yaml, err := ReadSource(source.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := r.link(source.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch SourceKind(yaml) {
+	case ActionKind:
+		if isActionFile(source.Path) {
+			return catalog.NewActionEntry(link, yaml)
+		}
+	case WorkflowKind:
+		return catalog.NewWorkflowEntry(link, yaml)
+	}
+
+	if source.Explicit {
+		return nil, fmt.Errorf("not found parser: invalid YAML file")
+	}
+	log.Printf("skipped: %s is neither Custom Action nor Reusable Workflow", source.Path)
+	return nil, nil
+}
+
+// link returns the path relative to the directory of the output file.
+func (r *CatalogRunner) link(path string) (string, error) {
+	base, err := filepath.Abs(filepath.Dir(r.OutputFile))
+	if err != nil {
+		return "", err
+	}
+	target, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(base, target)
+	if err != nil {
+		return "", err
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+func isActionFile(path string) bool {
+	name := filepath.Base(path)
+	return name == "action.yml" || name == "action.yaml"
+}
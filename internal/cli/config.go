@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/spf13/cobra"
+	"github.com/tmknom/actdocs/internal/conf"
+)
+
+func NewConfigCommand(configFile *string, io *IO) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "config",
+		Short: "Manage the config file",
+		// override the root command not to load the config file
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error { return nil },
+	}
+	command.AddCommand(NewConfigValidateCommand(configFile, io))
+	return command
+}
+
+func NewConfigValidateCommand(configFile *string, io *IO) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate [file]",
+		Short: "Validate the config file and report unknown keys",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			log.SetPrefix(fmt.Sprintf("[%s] [%s] ", AppName, cmd.Name()))
+			path := *configFile
+			if len(args) > 0 {
+				path = args[0]
+			}
+			return NewConfigValidateRunner(path, io).Run()
+		},
+	}
+}
+
+type ConfigValidateRunner struct {
+	path string
+	*IO
+}
+
+func NewConfigValidateRunner(path string, io *IO) *ConfigValidateRunner {
+	return &ConfigValidateRunner{
+		path: path,
+		IO:   io,
+	}
+}
+
+func (r *ConfigValidateRunner) Run() error {
+	path := r.path
+	if path == "" {
+		found, err := conf.FindFileConfig(".")
+		if err != nil {
+			return err
+		}
+		if found == "" {
+			return fmt.Errorf("not found config file: %v", conf.FileConfigNames)
+		}
+		path = found
+	}
+
+	if err := conf.ValidateFileConfig(path); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(r.OutWriter, "%s: valid\n", path)
+	return err
+}
@@ -1,72 +1,213 @@
 package cli
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io/fs"
 	"log"
-	"regexp"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/spf13/cobra"
-	"github.com/tmknom/actdocs/internal/action"
 	"github.com/tmknom/actdocs/internal/conf"
-	"github.com/tmknom/actdocs/internal/workflow"
 )
 
-func NewGenerateCommand(formatterConfig *conf.FormatterConfig, sortConfig *conf.SortConfig, io *IO) *cobra.Command {
-	option := &GenerateOption{IO: io}
-	return &cobra.Command{
+func NewGenerateCommand(formatterConfig *conf.FormatterConfig, sortConfig *conf.SortConfig, fileConfig *conf.FileConfig, io *IO) *cobra.Command {
+	option := &GenerateOption{FileConfig: fileConfig, IO: io}
+	command := &cobra.Command{
 		Use:   "generate",
 		Short: "Generate documentation",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			log.SetPrefix(fmt.Sprintf("[%s] [%s] ", AppName, cmd.Name()))
+			if option.Check && option.OutputFile == "" {
+				return fmt.Errorf("--check requires --output")
+			}
+			if err := ValidateKind(option.Kind); err != nil {
+				return err
+			}
 			if len(args) > 0 {
-				runner := NewGenerateRunner(args[0], formatterConfig, sortConfig, option)
+				runner := NewGenerateRunner(args, formatterConfig, sortConfig, option)
 				return runner.Run()
 			}
 			return cmd.Usage()
 		},
 	}
+
+	command.PersistentFlags().StringVarP(&option.OutputFile, "output", "o", "", "file path to write output into instead of stdout")
+	command.PersistentFlags().StringVar(&option.Kind, "kind", "", "kind of the source read from stdin with \"-\" [action workflow] (default: detected automatically)")
+	command.PersistentFlags().BoolVar(&option.Check, "check", false, "fail if the file specified by --output is out of date, without writing")
+	return command
 }
 
 type GenerateRunner struct {
-	source string
+	sources []string
 	*conf.FormatterConfig
 	*conf.SortConfig
 	*GenerateOption
+	reader *SourceReader
 }
 
-func NewGenerateRunner(source string, formatter *conf.FormatterConfig, sort *conf.SortConfig, option *GenerateOption) *GenerateRunner {
+func NewGenerateRunner(sources []string, formatter *conf.FormatterConfig, sort *conf.SortConfig, option *GenerateOption) *GenerateRunner {
 	return &GenerateRunner{
-		source:          source,
+		sources:         sources,
 		FormatterConfig: formatter,
 		SortConfig:      sort,
 		GenerateOption:  option,
+		reader:          NewSourceReader(option.InReader, option.Kind),
 	}
 }
 
 type GenerateOption struct {
+	OutputFile string
+	Check      bool
+	Kind       string
+	FileConfig *conf.FileConfig
 	*IO
 }
 
 func (r *GenerateRunner) Run() error {
-	yaml, err := ReadSource(r.source)
+	sources, err := ExpandSources(r.sources)
 	if err != nil {
 		return err
 	}
 
-	formatted, err := Generate(yaml, r.FormatterConfig, r.SortConfig)
+	//goland:noinspection GoPreferNilSlice
+	outputs := []*generatedOutput{}
+	var errs []error
+	for _, source := range sources {
+		output, err := r.generate(source)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", source.Path, err))
+			continue
+		}
+		if output == nil {
+			continue
+		}
+		outputs = append(outputs, output)
+	}
+
+	content, err := joinOutputs(outputs)
 	if err != nil {
-		return err
+		return errors.Join(append(errs, err)...)
+	}
+
+	if r.OutputFile == "" {
+		if _, err = fmt.Fprint(r.OutWriter, content); err != nil {
+			return err
+		}
+		return errors.Join(errs...)
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	return r.writeOutput(content)
+}
+
+// generatedOutput is the formatted output of the source with the format resolved by the config file.
+type generatedOutput struct {
+	Path    string
+	Content string
+	Format  string
+	Page    bool
+}
+
+// joinOutputs joins the outputs of the files, so that multiple files are still valid in the format.
+// JSON is joined into an array, YAML into documents separated by "---", and others with blank lines.
+// It returns error if the formats are mixed, or the full HTML pages are joined.
+func joinOutputs(outputs []*generatedOutput) (string, error) {
+	if len(outputs) == 0 {
+		return "", nil
+	}
+	if len(outputs) == 1 {
+		return outputs[0].Content + "\n", nil
+	}
+
+	first := outputs[0]
+	//goland:noinspection GoPreferNilSlice
+	contents := []string{}
+	for _, output := range outputs {
+		if output.Format != first.Format {
+			return "", fmt.Errorf("mixed formats in one output: %s is %s, but %s is %s", first.Path, first.Format, output.Path, output.Format)
+		}
+		if output.Format == conf.HtmlFormat && output.Page {
+			return "", fmt.Errorf("--page accepts only one source, but got %d: generate each page separately", len(outputs))
+		}
+		contents = append(contents, output.Content)
 	}
 
-	_, err = fmt.Fprintln(r.OutWriter, formatted)
+	switch first.Format {
+	case conf.JsonFormat:
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, []byte("["+strings.Join(contents, ",")+"]"), "", "  "); err != nil {
+			return "", err
+		}
+		return buf.String() + "\n", nil
+	case conf.YamlFormat:
+		return strings.Join(contents, "\n---\n") + "\n", nil
+	}
+	return strings.Join(contents, "\n\n") + "\n", nil
+}
+
+// writeOutput writes the content to the output file, or checks whether the output file is up-to-date with --check.
+func (r *GenerateRunner) writeOutput(content string) error {
+	status := UnchangedStatus
+	if r.Check {
+		current, err := os.ReadFile(r.OutputFile)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		if string(current) != content || err != nil {
+			return fmt.Errorf("%s: out of date: run generate with --output to update", r.OutputFile)
+		}
+	} else {
+		if err := os.MkdirAll(filepath.Dir(r.OutputFile), DefaultDirMode); err != nil {
+			return err
+		}
+		changed, err := WriteFile(r.OutputFile, content)
+		if err != nil {
+			return err
+		}
+		if changed {
+			status = UpdatedStatus
+		}
+	}
+
+	_, err := fmt.Fprintf(r.OutWriter, "%s: %s\n", status, r.OutputFile)
 	return err
 }
 
-func Generate(yaml []byte, formatter *conf.FormatterConfig, sort *conf.SortConfig) (string, error) {
-	if regexp.MustCompile(ActionRegex).Match(yaml) {
-		return action.Generate(yaml, formatter, sort)
-	} else if regexp.MustCompile(WorkflowRegex).Match(yaml) {
-		return workflow.Generate(yaml, formatter, sort)
+// generate returns nil if the source is skipped.
+func (r *GenerateRunner) generate(source *Source) (*generatedOutput, error) {
+	yaml, err := r.reader.Read(source.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	kind, err := r.reader.KindOf(source.Path, yaml)
+	if err != nil {
+		return nil, err
+	}
+	if !source.Explicit && kind == "" {
+		log.Printf("skipped: %s is neither Custom Action nor Reusable Workflow", source.Path)
+		return nil, nil
+	}
+
+	formatter, sort := r.FileConfig.Resolve(source.Path, r.FormatterConfig, r.SortConfig)
+	formatted, err := Generate(yaml, kind, formatter, sort)
+	if err != nil {
+		return nil, err
+	}
+	return &generatedOutput{Path: source.Path, Content: formatted, Format: formatter.Format, Page: formatter.Page}, nil
+}
+
+func Generate(yaml []byte, kind string, formatter *conf.FormatterConfig, sort *conf.SortConfig) (string, error) {
+	spec, err := ParseSpec(yaml, kind, formatter, sort)
+	if err != nil {
+		return "", err
 	}
-	return "", fmt.Errorf("not found parser: invalid YAML file")
+	return spec.Format(formatter.Format)
 }
@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tmknom/actdocs/internal/conf"
+)
+
+func TestGenerate(t *testing.T) {
+	cases := []struct {
+		name     string
+		fixture  string
+		kind     string
+		expected string
+	}{
+		{
+			name:     "action",
+			fixture:  complexActionFixture,
+			kind:     ActionKind,
+			expected: formatActionExpected,
+		},
+		{
+			name:     "workflow",
+			fixture:  complexWorkflowFixture,
+			kind:     WorkflowKind,
+			expected: formatWorkflowExpected,
+		},
+	}
+
+	sortConfig := &conf.SortConfig{Sort: true}
+	for _, tc := range cases {
+		got, err := Generate([]byte(tc.fixture), tc.kind, conf.DefaultFormatterConfig(), sortConfig)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.name, err)
+		}
+
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("%s: diff: %s", tc.name, diff)
+		}
+	}
+}
+
+func TestGenerateWithInvalidKind(t *testing.T) {
+	_, err := Generate([]byte(complexActionFixture), "", conf.DefaultFormatterConfig(), conf.DefaultSortConfig())
+	if !errors.Is(err, ErrInvalidSource) {
+		t.Errorf("expected: %s, but got: %v", ErrInvalidSource, err)
+	}
+}
+
+const complexActionFixture = `
+name: Test Fixture
+description: This is a test Custom Action for actdocs.
+
+inputs:
+  full-string:
+    default: "Default value"
+    required: true
+    description: "The full string value."
+  full-boolean:
+    default: true
+    required: false
+    description: "The full boolean value."
+  empty:
+
+outputs:
+  with-description:
+    description: "The Render value with description."
+    value: ${{ inputs.description-only }}
+  only-value:
+    value: "The Render value without description."
+`
+
+const formatActionExpected = `## Description
+
+This is a test Custom Action for actdocs.
+
+## Inputs
+
+| Name | Description | Default | Required |
+| :--- | :---------- | :------ | :------: |
+| full-string | The full string value. | ` + "`Default value`" + ` | yes |
+| empty |  | n/a | no |
+| full-boolean | The full boolean value. | ` + "`true`" + ` | no |
+
+## Outputs
+
+| Name | Description |
+| :--- | :---------- |
+| only-value |  |
+| with-description | The Render value with description. |`
+
+const complexWorkflowFixture = `
+on:
+  workflow_call:
+    inputs:
+      full-string:
+        default: ""
+        required: true
+        type: string
+        description: "The full string value."
+      full-boolean:
+        default: true
+        required: false
+        type: boolean
+        description: "The full boolean value."
+      empty:
+`
+
+const formatWorkflowExpected = `## Inputs
+
+| Name | Description | Type | Default | Required |
+| :--- | :---------- | :--- | :------ | :------: |
+| full-string | The full string value. | ` + "`string`" + ` | ` + "`\"\"`" + ` | yes |
+| empty |  | n/a | n/a | no |
+| full-boolean | The full boolean value. | ` + "`boolean`" + ` | ` + "`true`" + ` | no |
+
+## Secrets
+
+N/A
+
+## Outputs
+
+N/A
+
+## Permissions
+
+N/A`
@@ -1,28 +1,39 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
-	"io"
+	"io/fs"
 	"log"
 	"os"
-	"regexp"
+	"path/filepath"
+	"runtime"
+	"strings"
 
 	"github.com/spf13/cobra"
-	"github.com/tmknom/actdocs/internal/action"
 	"github.com/tmknom/actdocs/internal/conf"
-	"github.com/tmknom/actdocs/internal/workflow"
+	"github.com/tmknom/actdocs/internal/util"
 )
 
-func NewInjectCommand(formatter *conf.FormatterConfig, sort *conf.SortConfig, io *IO) *cobra.Command {
-	option := &InjectOption{IO: io}
+func NewInjectCommand(formatter *conf.FormatterConfig, sort *conf.SortConfig, fileConfig *conf.FileConfig, io *IO) *cobra.Command {
+	option := &InjectOption{FileConfig: fileConfig, IO: io}
 	command := &cobra.Command{
 		Use:   "inject",
 		Short: "Inject generated documentation to existing file",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			log.SetPrefix(fmt.Sprintf("[%s] [%s] ", AppName, cmd.Name()))
 			log.Printf("start: command = %s, option = %#v", cmd.Name(), option)
-			if len(args) > 0 {
-				runner := NewInjectRunner(args[0], formatter, sort, option)
+			if err := ValidateKind(option.Kind); err != nil {
+				return err
+			}
+			if option.Recursive {
+				if len(args) == 0 {
+					args = []string{"."}
+				}
+				return NewRecursiveInjectRunner(args, formatter, sort, option).Run()
+			}
+			if len(args) > 0 || option.OutputFile != "" {
+				runner := NewInjectRunner(args, formatter, sort, option)
 				return runner.Run()
 			}
 			return cmd.Usage()
@@ -31,19 +42,25 @@ func NewInjectCommand(formatter *conf.FormatterConfig, sort *conf.SortConfig, io
 
 	command.PersistentFlags().StringVarP(&option.OutputFile, "file", "f", "", "file path to insert output into (default \"\")")
 	command.PersistentFlags().BoolVar(&option.DryRun, "dry-run", false, "dry run")
+	command.PersistentFlags().BoolVarP(&option.Recursive, "recursive", "r", false, "inject into the file next to every action.yml (--file is relative to each action directory)")
+	command.PersistentFlags().IntVar(&option.Concurrency, "concurrency", runtime.NumCPU(), "number of files processed concurrently with --recursive")
+	command.PersistentFlags().StringVar(&option.Kind, "kind", "", "kind of the source read from stdin with \"-\" [action workflow] (default: detected automatically)")
+	command.PersistentFlags().BoolVar(&option.Create, "create", false, "create the file from a skeleton if it doesn't exist")
+	command.PersistentFlags().BoolVar(&option.Insert, "insert", false, "append markers at the end of the file if no markers exist")
+	command.PersistentFlags().StringVar(&option.InsertAfter, "insert-after", "", "insert markers after the heading such as \"## Usage\" if no markers exist")
 	return command
 }
 
 type InjectRunner struct {
-	source string
+	sources []string
 	*conf.FormatterConfig
 	*conf.SortConfig
 	*InjectOption
 }
 
-func NewInjectRunner(source string, formatter *conf.FormatterConfig, sort *conf.SortConfig, option *InjectOption) *InjectRunner {
+func NewInjectRunner(sources []string, formatter *conf.FormatterConfig, sort *conf.SortConfig, option *InjectOption) *InjectRunner {
 	return &InjectRunner{
-		source:          source,
+		sources:         sources,
 		FormatterConfig: formatter,
 		SortConfig:      sort,
 		InjectOption:    option,
@@ -51,40 +68,143 @@ func NewInjectRunner(source string, formatter *conf.FormatterConfig, sort *conf.
 }
 
 type InjectOption struct {
-	OutputFile string
-	DryRun     bool
+	OutputFile  string
+	DryRun      bool
+	Recursive   bool
+	Concurrency int
+	Create      bool
+	Insert      bool
+	InsertAfter string
+	Kind        string
+	FileConfig  *conf.FileConfig
 	*IO
 }
 
 func (r *InjectRunner) Run() error {
-	yaml, err := ReadSource(r.source)
+	cache := NewSpecCache(r.FormatterConfig, r.SortConfig, r.FileConfig, NewSourceReader(r.InReader, r.Kind))
+	if len(r.sources) == 0 {
+		return r.injectTo(r.OutputFile, "", cache)
+	}
+
+	sources, err := ExpandSources(r.sources)
 	if err != nil {
 		return err
 	}
+	if r.OutputFile != "" && len(sources) > 1 {
+		return fmt.Errorf("--file accepts only one source, but got %d: use the source attribute to inject multiple sources into one file", len(sources))
+	}
 
-	dest, err := os.Open(r.OutputFile)
+	var errs []error
+	for _, source := range sources {
+		if err = r.inject(source, cache); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (r *InjectRunner) inject(source *Source, cache *SpecCache) error {
+	if _, err := cache.Get(source.Path); err != nil {
+		if !source.Explicit && errors.Is(err, ErrInvalidSource) {
+			log.Printf("skipped: %s is neither Custom Action nor Reusable Workflow", source.Path)
+			return nil
+		}
+		return fmt.Errorf("%s: %w", source.Path, err)
+	}
+	return r.injectTo(r.outputFile(source), source.Path, cache)
+}
+
+// injectTo injects into the output file, where the directives without the source attribute refer to defaultSource.
+// The errors of the markers are reported with the output file, since they are found in it.
+func (r *InjectRunner) injectTo(outputFile string, defaultSource string, cache *SpecCache) error {
+	template, err := r.template(outputFile, defaultSource, cache)
 	if err != nil {
-		return err
+		return fmt.Errorf("%s: %w", outputFile, err)
 	}
-	defer func(file *os.File) { err = file.Close() }(dest)
 
-	result, err := Inject(yaml, dest, r.FormatterConfig, r.SortConfig)
+	resolver := cache.Resolver(defaultSource, filepath.Dir(outputFile))
+	syntax := util.SyntaxOf(outputFile)
+	result, err := util.NewSyntaxInjector(strings.NewReader(template), syntax, conf.AllSections, resolver).Inject()
 	if err != nil {
-		return err
+		return fmt.Errorf("%s: %w", outputFile, err)
 	}
 
 	if r.DryRun {
-		_, err = fmt.Fprintf(r.OutWriter, result)
+		_, err = fmt.Fprint(r.OutWriter, result)
 		return err
 	}
-	return os.WriteFile(r.OutputFile, []byte(result), 0644)
+
+	status, err := r.write(outputFile, result)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(r.OutWriter, "%s: %s\n", status, outputFile)
+	return err
+}
+
+// template returns the content of the output file, which is created from the skeleton if not exists with --create,
+// and the markers are inserted if not exist with --insert or --insert-after.
+func (o *InjectOption) template(outputFile string, defaultSource string, cache *SpecCache) (string, error) {
+	raw, err := os.ReadFile(outputFile)
+	template := string(raw)
+	if err != nil {
+		if !o.Create || !errors.Is(err, fs.ErrNotExist) {
+			return "", err
+		}
+		if template, err = o.skeleton(outputFile, defaultSource, cache); err != nil {
+			return "", err
+		}
+	}
+
+	syntax := util.SyntaxOf(outputFile)
+	if (o.Insert || o.InsertAfter != "") && !syntax.HasDirective(template, conf.AllSections) {
+		return syntax.InsertDirective(template, o.InsertAfter)
+	}
+	return template, nil
+}
+
+// skeleton returns the skeleton titled with the name of the source, or the file name if the name isn't specified.
+func (o *InjectOption) skeleton(outputFile string, source string, cache *SpecCache) (string, error) {
+	if source == "" {
+		return "", fmt.Errorf("not found source: specify the source file to create the file")
+	}
+
+	spec, err := cache.Get(source)
+	if err != nil {
+		return "", err
+	}
+
+	title := filepath.Base(source)
+	if spec.Name.IsValid() {
+		title = spec.Name.Value
+	}
+	return util.SyntaxOf(outputFile).Skeleton(title), nil
+}
+
+// write writes the content unless dry run, and returns whether the file is updated or unchanged.
+func (o *InjectOption) write(path string, content string) (string, error) {
+	if o.DryRun {
+		current, err := os.ReadFile(path)
+		if err == nil && string(current) == content {
+			return UnchangedStatus, nil
+		}
+		return UpdatedStatus, nil
+	}
+
+	changed, err := WriteFile(path, content)
+	if err != nil {
+		return FailedStatus, err
+	}
+	if changed {
+		return UpdatedStatus, nil
+	}
+	return UnchangedStatus, nil
 }
 
-func Inject(yaml []byte, reader io.Reader, formatter *conf.FormatterConfig, sort *conf.SortConfig) (string, error) {
-	if regexp.MustCompile(ActionRegex).Match(yaml) {
-		return action.Inject(yaml, reader, formatter, sort)
-	} else if regexp.MustCompile(WorkflowRegex).Match(yaml) {
-		return workflow.Inject(yaml, reader, formatter, sort)
+// outputFile returns the file specified by the flag, or the file specified by the config file.
+func (r *InjectRunner) outputFile(source *Source) string {
+	if r.OutputFile != "" {
+		return r.OutputFile
 	}
-	return "", fmt.Errorf("not found parser: invalid YAML file")
+	return r.FileConfig.OutputFile(source.Path)
 }
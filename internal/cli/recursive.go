@@ -0,0 +1,166 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/tmknom/actdocs/internal/conf"
+	"github.com/tmknom/actdocs/internal/util"
+)
+
+// RecursiveInjectRunner injects into the file next to every discovered action.yml.
+type RecursiveInjectRunner struct {
+	sources []string
+	*conf.FormatterConfig
+	*conf.SortConfig
+	*InjectOption
+	cache *SpecCache
+}
+
+func NewRecursiveInjectRunner(sources []string, formatter *conf.FormatterConfig, sort *conf.SortConfig, option *InjectOption) *RecursiveInjectRunner {
+	return &RecursiveInjectRunner{
+		sources:         sources,
+		FormatterConfig: formatter,
+		SortConfig:      sort,
+		InjectOption:    option,
+		cache:           NewSpecCache(formatter, sort, option.FileConfig, NewSourceReader(option.InReader, "")),
+	}
+}
+
+func (r *RecursiveInjectRunner) Run() error {
+	sources, err := ExpandSources(r.sources)
+	if err != nil {
+		return err
+	}
+
+	//goland:noinspection GoPreferNilSlice
+	actions := []*Source{}
+	for _, source := range sources {
+		if isActionFile(source.Path) {
+			actions = append(actions, source)
+		}
+	}
+
+	results := r.injectAll(actions)
+	return r.report(results)
+}
+
+func (r *RecursiveInjectRunner) injectAll(actions []*Source) []*InjectResult {
+	dests := make([]string, len(actions))
+	counts := map[string]int{}
+	for i, action := range actions {
+		dests[i] = r.outputFile(action)
+		counts[dests[i]]++
+	}
+
+	results := make([]*InjectResult, len(actions))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < r.concurrency(); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if counts[dests[i]] > 1 {
+					results[i] = NewFailedInjectResult(dests[i], fmt.Errorf("%s: shared with %d actions: use the source attribute instead", actions[i].Path, counts[dests[i]]))
+					continue
+				}
+				results[i] = r.inject(actions[i], dests[i])
+			}
+		}()
+	}
+
+	for i := range actions {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return results
+}
+
+func (r *RecursiveInjectRunner) inject(source *Source, dest string) *InjectResult {
+	log.Printf("inject: %s -> %s", source.Path, dest)
+
+	template, err := r.template(dest, source.Path, r.cache)
+	if err != nil {
+		return NewFailedInjectResult(dest, err)
+	}
+
+	syntax := util.SyntaxOf(dest)
+	if !syntax.HasDirective(template, conf.AllSections) {
+		return NewFailedInjectResult(dest, fmt.Errorf("not found markers: write %q and %q, or use --insert", syntax.BeginAllDirective(), syntax.EndAllDirective()))
+	}
+
+	resolver := r.cache.Resolver(source.Path, filepath.Dir(dest))
+	result, err := util.NewSyntaxInjector(strings.NewReader(template), syntax, conf.AllSections, resolver).Inject()
+	if err != nil {
+		return NewFailedInjectResult(dest, err)
+	}
+
+	status, err := r.write(dest, result)
+	if err != nil {
+		return NewFailedInjectResult(dest, err)
+	}
+	return &InjectResult{Path: dest, Status: status}
+}
+
+func (r *RecursiveInjectRunner) report(results []*InjectResult) error {
+	counts := map[string]int{}
+	var errs []error
+	for _, result := range results {
+		counts[result.Status]++
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", result.Path, result.Err))
+			_, _ = fmt.Fprintf(r.OutWriter, "%s: %s: %s\n", result.Status, result.Path, result.Err)
+		} else {
+			_, _ = fmt.Fprintf(r.OutWriter, "%s: %s\n", result.Status, result.Path)
+		}
+	}
+
+	_, err := fmt.Fprintf(r.OutWriter, "Summary: %d updated, %d unchanged, %d failed\n", counts[UpdatedStatus], counts[UnchangedStatus], counts[FailedStatus])
+	if err != nil {
+		return err
+	}
+	return errors.Join(errs...)
+}
+
+// outputFile returns the file relative to the action directory with --file, or the file of the config file.
+// It defaults to DefaultRecursiveFileName next to the action.
+func (r *RecursiveInjectRunner) outputFile(source *Source) string {
+	if r.OutputFile != "" {
+		return filepath.Join(filepath.Dir(source.Path), r.OutputFile)
+	}
+	if file := r.FileConfig.OutputFile(source.Path); file != "" {
+		return file
+	}
+	return filepath.Join(filepath.Dir(source.Path), DefaultRecursiveFileName)
+}
+
+func (r *RecursiveInjectRunner) concurrency() int {
+	if r.Concurrency < 1 {
+		return 1
+	}
+	return r.Concurrency
+}
+
+const DefaultRecursiveFileName = "README.md"
+
+type InjectResult struct {
+	Path   string
+	Status string
+	Err    error
+}
+
+func NewFailedInjectResult(path string, err error) *InjectResult {
+	return &InjectResult{Path: path, Status: FailedStatus, Err: err}
+}
+
+const (
+	UpdatedStatus   = "updated"
+	UnchangedStatus = "unchanged"
+	FailedStatus    = "failed"
+)
@@ -1,8 +1,16 @@
 package cli
 
 import (
+	"errors"
+	"fmt"
 	"io"
+	"io/fs"
+	"log"
 	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tmknom/actdocs/internal/util"
 )
 
 func ReadSource(filename string) (raw []byte, err error) {
@@ -14,3 +22,260 @@ func ReadSource(filename string) (raw []byte, err error) {
 
 	return io.ReadAll(file)
 }
+
+// SourceReader reads the sources, where StdinSource is read from InReader only once.
+// Kind overrides the kind of StdinSource, or the kind is detected automatically if empty.
+type SourceReader struct {
+	InReader io.Reader
+	Kind     string
+	stdin    []byte
+	read     bool
+}
+
+func NewSourceReader(inReader io.Reader, kind string) *SourceReader {
+	return &SourceReader{
+		InReader: inReader,
+		Kind:     kind,
+	}
+}
+
+func (r *SourceReader) Read(path string) ([]byte, error) {
+	if path != StdinSource {
+		return ReadSource(path)
+	}
+
+	if !r.read {
+		raw, err := io.ReadAll(r.InReader)
+		if err != nil {
+			return nil, err
+		}
+		r.stdin = raw
+		r.read = true
+	}
+	return r.stdin, nil
+}
+
+// KindOf returns the kind of the source, or empty string if it is neither Custom Action nor Reusable Workflow.
+// It returns error if the kind of StdinSource can't be detected, since stdin can't be skipped.
+func (r *SourceReader) KindOf(path string, yaml []byte) (string, error) {
+	if path != StdinSource {
+		return SourceKind(yaml), nil
+	}
+
+	if r.Kind != "" {
+		return r.Kind, nil
+	}
+	if kind := SourceKind(yaml); kind != "" {
+		return kind, nil
+	}
+	return "", fmt.Errorf("%w: specify --kind to read from stdin", ErrInvalidSource)
+}
+
+// ValidateKind returns error if the kind is neither empty, ActionKind nor WorkflowKind.
+func ValidateKind(kind string) error {
+	if kind != "" && kind != ActionKind && kind != WorkflowKind {
+		return fmt.Errorf("invalid kind: %s, valid values are [%s %s]", kind, ActionKind, WorkflowKind)
+	}
+	return nil
+}
+
+// StdinSource is the source read from stdin.
+const StdinSource = "-"
+
+// Source is a file to be documented.
+// Explicit is false when the file was discovered from a directory or a glob pattern.
+type Source struct {
+	Path     string
+	Explicit bool
+}
+
+// ExpandSources resolves files, directories and glob patterns into a list of sources.
+// Errors are reported for all arguments together, rather than stopping at the first one.
+func ExpandSources(args []string) ([]*Source, error) {
+	//goland:noinspection GoPreferNilSlice
+	result := []*Source{}
+	seen := map[string]bool{}
+	var errs []error
+	appendSource := func(path string, explicit bool) {
+		path = filepath.Clean(path)
+		if !seen[path] {
+			seen[path] = true
+			result = append(result, &Source{Path: path, Explicit: explicit})
+		}
+	}
+
+	for _, arg := range args {
+		if arg == StdinSource {
+			appendSource(arg, true)
+			continue
+		}
+
+		if hasGlobMeta(arg) {
+			matches, err := globFiles(arg)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if len(matches) == 0 {
+				errs = append(errs, fmt.Errorf("no files match the pattern: %s", arg))
+				continue
+			}
+			for _, match := range matches {
+				appendSource(match, false)
+			}
+			continue
+		}
+
+		info, err := os.Stat(arg)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !info.IsDir() {
+			appendSource(arg, true)
+			continue
+		}
+
+		files, err := walkYamlFiles(arg)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, file := range files {
+			appendSource(file, false)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return result, nil
+}
+
+// SourceKind returns the kind of the yaml, or empty string if it is neither Custom Action nor Reusable Workflow.
+func SourceKind(yaml []byte) string {
+	return util.DetectKind(yaml)
+}
+
+const (
+	ActionKind   = util.ActionKind
+	WorkflowKind = util.WorkflowKind
+)
+
+func walkYamlFiles(root string) ([]string, error) {
+	//goland:noinspection GoPreferNilSlice
+	files := []string{}
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != root && d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if isYamlFile(path) {
+			files = append(files, path)
+		}
+		return nil
+	})
+	return files, err
+}
+
+func globFiles(pattern string) ([]string, error) {
+	base := globBase(pattern)
+	patternSegments := splitPath(filepath.ToSlash(filepath.Clean(pattern)))
+
+	//goland:noinspection GoPreferNilSlice
+	files := []string{}
+	err := filepath.WalkDir(base, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && path == base {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if d.IsDir() {
+			if path != base && d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if matchSegments(patternSegments, splitPath(filepath.ToSlash(path))) {
+			files = append(files, path)
+		}
+		return nil
+	})
+	log.Printf("glob: pattern = %s, files = %q", pattern, files)
+	return files, err
+}
+
+// globBase returns the longest leading directory of the pattern without glob meta characters.
+func globBase(pattern string) string {
+	segments := splitPath(filepath.ToSlash(filepath.Clean(pattern)))
+	//goland:noinspection GoPreferNilSlice
+	base := []string{}
+	for _, segment := range segments[:len(segments)-1] {
+		if hasGlobMeta(segment) {
+			break
+		}
+		base = append(base, segment)
+	}
+
+	if len(base) == 0 {
+		if filepath.IsAbs(pattern) {
+			return string(filepath.Separator)
+		}
+		return "."
+	}
+	result := filepath.FromSlash(strings.Join(base, "/"))
+	if filepath.IsAbs(pattern) && !filepath.IsAbs(result) {
+		result = string(filepath.Separator) + result
+	}
+	return result
+}
+
+// matchSegments matches the path segments, where "**" matches zero or more directories.
+func matchSegments(pattern []string, path []string) bool {
+	if len(pattern) == 0 {
+		return len(path) == 0
+	}
+
+	if pattern[0] == "**" {
+		for i := 0; i <= len(path); i++ {
+			if matchSegments(pattern[1:], path[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(path) == 0 {
+		return false
+	}
+	matched, err := filepath.Match(pattern[0], path[0])
+	if err != nil || !matched {
+		return false
+	}
+	return matchSegments(pattern[1:], path[1:])
+}
+
+func splitPath(path string) []string {
+	//goland:noinspection GoPreferNilSlice
+	result := []string{}
+	for _, segment := range strings.Split(path, "/") {
+		if segment != "" && segment != "." {
+			result = append(result, segment)
+		}
+	}
+	return result
+}
+
+func hasGlobMeta(path string) bool {
+	return strings.ContainsAny(path, "*?[")
+}
+
+func isYamlFile(path string) bool {
+	ext := filepath.Ext(path)
+	return ext == ".yml" || ext == ".yaml"
+}
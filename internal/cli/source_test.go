@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandSources(t *testing.T) {
+	cases := []struct {
+		name     string
+		args     []string
+		expected []*Source
+	}{
+		{
+			name: "file",
+			args: []string{testBaseDir + "testdata/valid-action.yml"},
+			expected: []*Source{
+				{Path: "../../testdata/valid-action.yml", Explicit: true},
+			},
+		},
+		{
+			name: "stdin",
+			args: []string{"-", testBaseDir + "testdata/valid-action.yml"},
+			expected: []*Source{
+				{Path: "-", Explicit: true},
+				{Path: "../../testdata/valid-action.yml", Explicit: true},
+			},
+		},
+		{
+			name: "directory",
+			args: []string{testBaseDir + "testdata/discovery"},
+			expected: []*Source{
+				{Path: "../../testdata/discovery/.github/workflows/reusable.yml", Explicit: false},
+				{Path: "../../testdata/discovery/action.yml", Explicit: false},
+				{Path: "../../testdata/discovery/dependabot.yml", Explicit: false},
+			},
+		},
+		{
+			name: "glob",
+			args: []string{testBaseDir + "testdata/valid-*-action.yml"},
+			expected: []*Source{
+				{Path: "../../testdata/valid-empty-action.yml", Explicit: false},
+			},
+		},
+		{
+			name: "double star glob",
+			args: []string{testBaseDir + "testdata/discovery/**/action.yml"},
+			expected: []*Source{
+				{Path: "../../testdata/discovery/action.yml", Explicit: false},
+			},
+		},
+		{
+			name: "duplicated",
+			args: []string{testBaseDir + "testdata/valid-action.yml", testBaseDir + "testdata/./valid-action.yml"},
+			expected: []*Source{
+				{Path: "../../testdata/valid-action.yml", Explicit: true},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		got, err := ExpandSources(tc.args)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.name, err)
+		}
+
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("%s: diff: %s", tc.name, diff)
+		}
+	}
+}
+
+func TestExpandSourcesWithError(t *testing.T) {
+	args := []string{testBaseDir + "testdata/nomatch/*.yml", testBaseDir + "testdata/valid-action.yml", testBaseDir + "testdata/*.json"}
+	_, err := ExpandSources(args)
+
+	expected := "no files match the pattern: ../../testdata/nomatch/*.yml\nno files match the pattern: ../../testdata/*.json"
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected: %s, but got: %v", expected, err)
+	}
+}
+
+func TestMatchSegments(t *testing.T) {
+	cases := []struct {
+		pattern  string
+		path     string
+		expected bool
+	}{
+		{pattern: ".github/workflows/*.yml", path: ".github/workflows/lint.yml", expected: true},
+		{pattern: ".github/workflows/*.yml", path: ".github/workflows/lint.yaml", expected: false},
+		{pattern: "actions/**/action.yml", path: "actions/action.yml", expected: true},
+		{pattern: "actions/**/action.yml", path: "actions/foo/bar/action.yml", expected: true},
+		{pattern: "actions/**/action.yml", path: "other/foo/action.yml", expected: false},
+		{pattern: "**", path: "foo/bar.yml", expected: true},
+	}
+
+	for _, tc := range cases {
+		got := matchSegments(splitPath(tc.pattern), splitPath(tc.path))
+		if got != tc.expected {
+			t.Errorf("%s, %s: expected %t, got %t", tc.pattern, tc.path, tc.expected, got)
+		}
+	}
+}
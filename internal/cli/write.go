@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// WriteFile writes the content via a temporary file and renames it, so the file is never left half-written.
+// It preserves the mode of the existing file, and returns false without writing if the content is unchanged.
+// The symbolic link is resolved, so the file it points to is written instead of replacing the link.
+func WriteFile(path string, content string) (changed bool, err error) {
+	resolved, err := filepath.EvalSymlinks(path)
+	if err == nil {
+		path = resolved
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return false, err
+	}
+
+	mode := DefaultFileMode
+	info, err := os.Stat(path)
+	if err == nil {
+		current, err := os.ReadFile(path)
+		if err != nil {
+			return false, err
+		}
+		if string(current) == content {
+			return false, nil
+		}
+		mode = info.Mode().Perm()
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return false, err
+	}
+
+	temp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return false, err
+	}
+	defer func(name string) {
+		if err != nil {
+			_ = os.Remove(name)
+		}
+	}(temp.Name())
+
+	if _, err = temp.WriteString(content); err != nil {
+		_ = temp.Close()
+		return false, err
+	}
+	if err = temp.Chmod(mode); err != nil {
+		_ = temp.Close()
+		return false, err
+	}
+	if err = temp.Close(); err != nil {
+		return false, err
+	}
+	if err = os.Rename(temp.Name(), path); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+const DefaultFileMode fs.FileMode = 0644
+const DefaultDirMode fs.FileMode = 0755
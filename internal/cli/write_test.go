@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteFile(t *testing.T) {
+	cases := []struct {
+		name     string
+		current  *string
+		mode     fs.FileMode
+		content  string
+		expected bool
+		wantMode fs.FileMode
+	}{
+		{
+			name:     "create",
+			current:  nil,
+			content:  "new\n",
+			expected: true,
+			wantMode: DefaultFileMode,
+		},
+		{
+			name:     "update with mode preserved",
+			current:  stringPtr("old\n"),
+			mode:     0600,
+			content:  "new\n",
+			expected: true,
+			wantMode: 0600,
+		},
+		{
+			name:     "unchanged",
+			current:  stringPtr("same\n"),
+			mode:     0640,
+			content:  "same\n",
+			expected: false,
+			wantMode: 0640,
+		},
+	}
+
+	for _, tc := range cases {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "README.md")
+		if tc.current != nil {
+			if err := os.WriteFile(path, []byte(*tc.current), tc.mode); err != nil {
+				t.Fatalf("%s: unexpected error: %s", tc.name, err)
+			}
+			if err := os.Chmod(path, tc.mode); err != nil {
+				t.Fatalf("%s: unexpected error: %s", tc.name, err)
+			}
+		}
+
+		got, err := WriteFile(path, tc.content)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.name, err)
+		}
+		if got != tc.expected {
+			t.Errorf("%s: expected: %t, but got: %t", tc.name, tc.expected, got)
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil || string(content) != tc.content {
+			t.Errorf("%s: expected content: %q, but got: %q (%v)", tc.name, tc.content, content, err)
+		}
+		info, err := os.Stat(path)
+		if err != nil || info.Mode().Perm() != tc.wantMode {
+			t.Errorf("%s: expected mode: %s, but got: %s (%v)", tc.name, tc.wantMode, info.Mode().Perm(), err)
+		}
+		entries, err := os.ReadDir(dir)
+		if err != nil || len(entries) != 1 {
+			t.Errorf("%s: expected no temporary files, but got: %v (%v)", tc.name, entries, err)
+		}
+	}
+}
+
+func TestWriteFileWithSymlink(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "README.md")
+	if err := os.WriteFile(target, []byte("old\n"), 0600); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	link := filepath.Join(dir, "link.md")
+	if err := os.Symlink("README.md", link); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := WriteFile(link, "new\n"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	info, err := os.Lstat(link)
+	if err != nil || info.Mode()&fs.ModeSymlink == 0 {
+		t.Errorf("expected the symbolic link is kept, but got: %v (%v)", info, err)
+	}
+	content, err := os.ReadFile(target)
+	if err != nil || string(content) != "new\n" {
+		t.Errorf("expected the target is written, but got: %q (%v)", content, err)
+	}
+	info, err = os.Stat(target)
+	if err != nil || info.Mode().Perm() != 0600 {
+		t.Errorf("expected the file mode is preserved, but got: %v (%v)", info, err)
+	}
+}
+
+func stringPtr(value string) *string {
+	return &value
+}
@@ -0,0 +1,115 @@
+package conf
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/tmknom/actdocs/internal/util"
+)
+
+// ApplyAttributes returns a copy of the formatter config with the directive attributes applied,
+// and a sort config if the sort attribute is specified, or nil.
+func ApplyAttributes(attributes map[string]string, section string, formatter *FormatterConfig) (*FormatterConfig, *SortConfig, error) {
+	result := *formatter
+	var sortConfig *SortConfig
+	for key, value := range attributes {
+		switch key {
+		case OmitKey:
+			omit, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, nil, invalidAttributeError(key, value)
+			}
+			result.Omit = omit
+		case HeadingOffsetKey:
+			offset, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, nil, invalidAttributeError(key, value)
+			}
+			result.HeadingOffset = offset
+		case HeadingAttribute:
+			level, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, nil, invalidAttributeError(key, value)
+			}
+			result.HeadingOffset = level - util.DefaultHeadingLevel
+		case TitleAttribute:
+			if section == "" {
+				return nil, nil, fmt.Errorf("invalid attribute: %s is available only for section directives", key)
+			}
+			result.Titles = copyWith(result.Titles, section, value)
+		case ColumnsAttribute:
+			if section == "" {
+				return nil, nil, fmt.Errorf("invalid attribute: %s is available only for section directives", key)
+			}
+			result.SetColumns(section, splitList(value))
+		case LayoutKey:
+			if section == "" {
+				result.Layout = value
+			} else {
+				result.Layouts = copyWith(result.Layouts, section, value)
+			}
+		case SectionsKey:
+			result.Sections = splitList(value)
+		case SortKey:
+			config, err := parseSortAttribute(value)
+			if err != nil {
+				return nil, nil, err
+			}
+			sortConfig = config
+		case util.SourceAttribute:
+			// resolved by the injector
+		default:
+			return nil, nil, fmt.Errorf("unknown attribute: %s", key)
+		}
+	}
+
+	if err := result.Validate(); err != nil {
+		return nil, nil, err
+	}
+	return &result, sortConfig, nil
+}
+
+func parseSortAttribute(value string) (*SortConfig, error) {
+	switch value {
+	case "true":
+		return &SortConfig{Sort: true}, nil
+	case "name":
+		return &SortConfig{SortByName: true}, nil
+	case "required":
+		return &SortConfig{SortByRequired: true}, nil
+	case "false":
+		return DefaultSortConfig(), nil
+	}
+	return nil, invalidAttributeError(SortKey, value)
+}
+
+func invalidAttributeError(key string, value string) error {
+	return fmt.Errorf("invalid attribute: %s=%s", key, value)
+}
+
+func copyWith(m map[string]string, key string, value string) map[string]string {
+	result := map[string]string{}
+	for k, v := range m {
+		result[k] = v
+	}
+	result[key] = value
+	return result
+}
+
+func splitList(value string) []string {
+	//goland:noinspection GoPreferNilSlice
+	result := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+const (
+	HeadingAttribute = "heading"
+	TitleAttribute   = "title"
+	ColumnsAttribute = "columns"
+)
@@ -0,0 +1,97 @@
+package conf
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestApplyAttributes(t *testing.T) {
+	cases := []struct {
+		name       string
+		attributes map[string]string
+		section    string
+		formatter  func(c *FormatterConfig)
+		sort       *SortConfig
+	}{
+		{
+			name:       "empty",
+			attributes: map[string]string{},
+			formatter:  func(c *FormatterConfig) {},
+			sort:       nil,
+		},
+		{
+			name:       "formatter",
+			attributes: map[string]string{"omit": "true", "heading": "3", "title": "Parameters", "columns": "name, required"},
+			section:    "inputs",
+			formatter: func(c *FormatterConfig) {
+				c.Omit = true
+				c.HeadingOffset = 1
+				c.Titles = map[string]string{"inputs": "Parameters"}
+				c.Columns = map[string][]string{"inputs": {"name", "required"}}
+			},
+			sort: nil,
+		},
+		{
+			name:       "layout",
+			attributes: map[string]string{"layout": "list"},
+			formatter:  func(c *FormatterConfig) { c.Layout = "list" },
+			sort:       nil,
+		},
+		{
+			name:       "section layout",
+			attributes: map[string]string{"layout": "list"},
+			section:    "outputs",
+			formatter:  func(c *FormatterConfig) { c.Layouts = map[string]string{"outputs": "list"} },
+			sort:       nil,
+		},
+		{
+			name:       "sort",
+			attributes: map[string]string{"sort": "name", "sections": "outputs,inputs"},
+			formatter:  func(c *FormatterConfig) { c.Sections = []string{"outputs", "inputs"} },
+			sort:       &SortConfig{SortByName: true},
+		},
+	}
+
+	for _, tc := range cases {
+		gotFormatter, gotSort, err := ApplyAttributes(tc.attributes, tc.section, DefaultFormatterConfig())
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.name, err)
+		}
+
+		expected := DefaultFormatterConfig()
+		tc.formatter(expected)
+		if diff := cmp.Diff(gotFormatter, expected); diff != "" {
+			t.Errorf("%s: formatter diff: %s", tc.name, diff)
+		}
+		if diff := cmp.Diff(gotSort, tc.sort); diff != "" {
+			t.Errorf("%s: sort diff: %s", tc.name, diff)
+		}
+	}
+}
+
+func TestApplyAttributesError(t *testing.T) {
+	cases := []struct {
+		name       string
+		attributes map[string]string
+		section    string
+		expected   string
+	}{
+		{name: "unknown", attributes: map[string]string{"foo": "bar"}, expected: "unknown attribute: foo"},
+		{name: "invalid omit", attributes: map[string]string{"omit": "yes!"}, expected: "invalid attribute: omit=yes!"},
+		{name: "invalid sort", attributes: map[string]string{"sort": "type"}, expected: "invalid attribute: sort=type"},
+		{name: "title without section", attributes: map[string]string{"title": "Foo"}, expected: "invalid attribute: title is available only for section directives"},
+		{name: "invalid layout", attributes: map[string]string{"layout": "grid"}, section: "inputs", expected: "invalid layout: grid, valid values are [table list]"},
+		{name: "unknown column", attributes: map[string]string{"columns": "foo"}, section: "outputs", expected: "unknown outputs column: foo, valid values are [name description]"},
+	}
+
+	for _, tc := range cases {
+		_, _, err := ApplyAttributes(tc.attributes, tc.section, DefaultFormatterConfig())
+		if err == nil {
+			t.Fatalf("%s: expected error", tc.name)
+		}
+		if diff := cmp.Diff(err.Error(), tc.expected); diff != "" {
+			t.Errorf("%s: diff: %s", tc.name, diff)
+		}
+	}
+}
@@ -0,0 +1,310 @@
+package conf
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// FileConfig is the project configuration file, which sets defaults of the flags.
+// Flags take precedence over the configuration file.
+type FileConfig struct {
+	Settings  `yaml:",inline"`
+	Overrides []*OverrideConfig `yaml:"overrides"`
+
+	// Path is the configuration file path, or empty if not found.
+	Path     string          `yaml:"-"`
+	explicit map[string]bool `yaml:"-"`
+}
+
+func NewFileConfig() *FileConfig {
+	return &FileConfig{
+		Overrides: []*OverrideConfig{},
+		explicit:  map[string]bool{},
+	}
+}
+
+// OverrideConfig overrides settings for sources under the Path, which is a directory or glob pattern
+// relative to the configuration file.
+type OverrideConfig struct {
+	Path     string `yaml:"path"`
+	Settings `yaml:",inline"`
+}
+
+type Settings struct {
+	Format         *string             `yaml:"format"`
+	Omit           *bool               `yaml:"omit"`
+	Sections       []string            `yaml:"sections"`
+	OmitSections   []string            `yaml:"omit-sections"`
+	HeadingOffset  *int                `yaml:"heading-offset"`
+	Titles         map[string]string   `yaml:"titles"`
+	Columns        map[string][]string `yaml:"columns"`
+	Page           *bool               `yaml:"page"`
+	Layout         *string             `yaml:"layout"`
+	Layouts        map[string]string   `yaml:"layouts"`
+	Sort           *bool               `yaml:"sort"`
+	SortByName     *bool               `yaml:"sort-by-name"`
+	SortByRequired *bool               `yaml:"sort-by-required"`
+	File           *string             `yaml:"file"`
+}
+
+// FindFileConfig searches the configuration file from the directory upward, and returns empty string if not found.
+func FindFileConfig(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		for _, name := range FileConfigNames {
+			candidate := filepath.Join(dir, name)
+			if _, err = os.Stat(candidate); err == nil {
+				return candidate, nil
+			}
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
+
+func LoadFileConfig(filename string) (*FileConfig, error) {
+	raw, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	config := NewFileConfig()
+	if err = yaml.Unmarshal(raw, config); err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	if err = config.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	config.Path = filename
+	return config, nil
+}
+
+// ValidateFileConfig reports unknown keys in the configuration file.
+func ValidateFileConfig(filename string) error {
+	raw, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	config := NewFileConfig()
+	err = yaml.UnmarshalStrict(raw, config)
+	var typeError *yaml.TypeError
+	if errors.As(err, &typeError) {
+		return fmt.Errorf("%s: invalid config:\n  %s", filename, strings.Join(rewriteTypeErrors(typeError.Errors), "\n  "))
+	} else if err != nil {
+		return fmt.Errorf("%s: %w", filename, err)
+	}
+
+	if err = config.validate(); err != nil {
+		return fmt.Errorf("%s: %w", filename, err)
+	}
+	return nil
+}
+
+// rewriteTypeErrors rewrites the errors of unknown fields with the key, rather than the Go type names.
+func rewriteTypeErrors(errs []string) []string {
+	//goland:noinspection GoPreferNilSlice
+	result := []string{}
+	for _, err := range errs {
+		result = append(result, unknownFieldPattern.ReplaceAllString(err, "${1}unknown key: ${2}"))
+	}
+	return result
+}
+
+var unknownFieldPattern = regexp.MustCompile(`^(line \d+: )?field (\S+) not found in type \S+$`)
+
+func (c *FileConfig) validate() error {
+	if err := c.Settings.validate(); err != nil {
+		return err
+	}
+	for _, override := range c.Overrides {
+		if err := override.Settings.validate(); err != nil {
+			return fmt.Errorf("overrides %s: %w", override.Path, err)
+		}
+	}
+	return nil
+}
+
+// SetExplicit marks the settings specified by flags, so that the configuration file doesn't override them.
+func (c *FileConfig) SetExplicit(names ...string) {
+	for _, name := range names {
+		c.explicit[name] = true
+	}
+}
+
+// ApplyDefaults applies top-level settings to the configs.
+func (c *FileConfig) ApplyDefaults(formatter *FormatterConfig, sort *SortConfig) {
+	c.Settings.apply(formatter, sort, c.explicit)
+}
+
+// Resolve returns copies of the configs with the overrides matching to the source applied.
+func (c *FileConfig) Resolve(source string, formatter *FormatterConfig, sort *SortConfig) (*FormatterConfig, *SortConfig) {
+	resolvedFormatter := *formatter
+	resolvedSort := *sort
+	for _, override := range c.matchedOverrides(source) {
+		override.Settings.apply(&resolvedFormatter, &resolvedSort, c.explicit)
+	}
+	return &resolvedFormatter, &resolvedSort
+}
+
+// OutputFile returns the file for the source relative to the configuration file, or empty string if not specified.
+func (c *FileConfig) OutputFile(source string) string {
+	file := c.File
+	for _, override := range c.matchedOverrides(source) {
+		if override.File != nil {
+			file = override.File
+		}
+	}
+
+	if file == nil || *file == "" {
+		return ""
+	}
+	return filepath.Join(filepath.Dir(c.Path), filepath.FromSlash(*file))
+}
+
+func (c *FileConfig) matchedOverrides(source string) []*OverrideConfig {
+	//goland:noinspection GoPreferNilSlice
+	result := []*OverrideConfig{}
+	if c.Path == "" {
+		return result
+	}
+
+	rel, err := c.relativePath(source)
+	if err != nil {
+		return result
+	}
+
+	for _, override := range c.Overrides {
+		if override.match(rel) {
+			result = append(result, override)
+		}
+	}
+	return result
+}
+
+func (c *FileConfig) relativePath(source string) (string, error) {
+	base, err := filepath.Abs(filepath.Dir(c.Path))
+	if err != nil {
+		return "", err
+	}
+	target, err := filepath.Abs(source)
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(base, target)
+	if err != nil {
+		return "", err
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+func (o *OverrideConfig) match(rel string) bool {
+	pattern := strings.TrimSuffix(path.Clean(o.Path), "/")
+	if rel == pattern || strings.HasPrefix(rel, pattern+"/") {
+		return true
+	}
+
+	for target := rel; target != "." && target != "/"; target = path.Dir(target) {
+		if matched, err := path.Match(pattern, target); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Settings) apply(formatter *FormatterConfig, sort *SortConfig, explicit map[string]bool) {
+	if s.Format != nil && !explicit[FormatKey] {
+		formatter.Format = *s.Format
+	}
+	if s.Omit != nil && !explicit[OmitKey] {
+		formatter.Omit = *s.Omit
+	}
+	if s.Sections != nil && !explicit[SectionsKey] {
+		formatter.Sections = s.Sections
+	}
+	if s.OmitSections != nil && !explicit[OmitSectionsKey] {
+		formatter.OmitSections = s.OmitSections
+	}
+	if s.HeadingOffset != nil && !explicit[HeadingOffsetKey] {
+		formatter.HeadingOffset = *s.HeadingOffset
+	}
+	if s.Titles != nil && !explicit[TitlesKey] {
+		formatter.Titles = s.Titles
+	}
+	if s.Page != nil && !explicit[PageKey] {
+		formatter.Page = *s.Page
+	}
+	if s.Layout != nil && !explicit[LayoutKey] {
+		formatter.Layout = *s.Layout
+	}
+	if s.Layouts != nil && !explicit[LayoutsKey] {
+		formatter.Layouts = s.Layouts
+	}
+	for section, keys := range s.Columns {
+		if !explicit[ColumnsKey(section)] {
+			formatter.SetColumns(section, keys)
+		}
+	}
+	if s.Sort != nil && !explicit[SortKey] {
+		sort.Sort = *s.Sort
+	}
+	if s.SortByName != nil && !explicit[SortByNameKey] {
+		sort.SortByName = *s.SortByName
+	}
+	if s.SortByRequired != nil && !explicit[SortByRequiredKey] {
+		sort.SortByRequired = *s.SortByRequired
+	}
+}
+
+func (s *Settings) validate() error {
+	if s.Format != nil {
+		if err := ValidateFormat(*s.Format); err != nil {
+			return err
+		}
+	}
+	if err := validateSectionSettings(s.Sections, s.OmitSections, s.Titles, s.Columns); err != nil {
+		return err
+	}
+	layout := ""
+	if s.Layout != nil {
+		layout = *s.Layout
+	}
+	return ValidateLayouts(layout, s.Layouts)
+}
+
+// ColumnsKey returns the flag name of the columns for the section.
+func ColumnsKey(section string) string {
+	return section + "-columns"
+}
+
+var FileConfigNames = []string{".actdocs.yml", ".actdocs.yaml"}
+
+const (
+	FormatKey         = "format"
+	OmitKey           = "omit"
+	SectionsKey       = "sections"
+	OmitSectionsKey   = "omit-sections"
+	HeadingOffsetKey  = "heading-offset"
+	TitlesKey         = "titles"
+	PageKey           = "page"
+	LayoutKey         = "layout"
+	LayoutsKey        = "layouts"
+	SortKey           = "sort"
+	SortByNameKey     = "sort-by-name"
+	SortByRequiredKey = "sort-by-required"
+)
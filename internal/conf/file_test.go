@@ -0,0 +1,108 @@
+package conf
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFileConfig_OutputFile(t *testing.T) {
+	readme := "README.md"
+	action := "docs/action.md"
+	sut := &FileConfig{
+		Settings: Settings{File: &readme},
+		Overrides: []*OverrideConfig{
+			{Path: "actions/*", Settings: Settings{File: &action}},
+		},
+		Path: "/repo/.actdocs.yml",
+	}
+
+	cases := []struct {
+		source   string
+		expected string
+	}{
+		{source: "/repo/action.yml", expected: "/repo/README.md"},
+		{source: "/repo/actions/foo/action.yml", expected: "/repo/docs/action.md"},
+		{source: "/other/action.yml", expected: "/repo/README.md"},
+	}
+
+	for _, tc := range cases {
+		got := sut.OutputFile(tc.source)
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("%s: diff: %s", tc.source, diff)
+		}
+	}
+}
+
+func TestFileConfig_Resolve(t *testing.T) {
+	omit := true
+	json := "json"
+	sut := &FileConfig{
+		Overrides: []*OverrideConfig{
+			{Path: "actions", Settings: Settings{Omit: &omit, Format: &json}},
+		},
+		Path:     "/repo/.actdocs.yml",
+		explicit: map[string]bool{FormatKey: true},
+	}
+
+	cases := []struct {
+		source   string
+		expected *FormatterConfig
+	}{
+		{source: "/repo/action.yml", expected: DefaultFormatterConfig()},
+		{source: "/repo/actions/foo/action.yml", expected: func() *FormatterConfig { c := DefaultFormatterConfig(); c.Omit = true; return c }()},
+	}
+
+	for _, tc := range cases {
+		got, _ := sut.Resolve(tc.source, DefaultFormatterConfig(), DefaultSortConfig())
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("%s: diff: %s", tc.source, diff)
+		}
+	}
+}
+
+func TestFormatterConfig_LayoutOf(t *testing.T) {
+	sut := &FormatterConfig{Layout: ListLayout, Layouts: map[string]string{OutputsSection: TableLayout}}
+	if got := sut.LayoutOf(InputsSection); got != ListLayout {
+		t.Errorf("expected: %s, but got: %s", ListLayout, got)
+	}
+	if got := sut.LayoutOf(OutputsSection); got != TableLayout {
+		t.Errorf("expected: %s, but got: %s", TableLayout, got)
+	}
+	if got := (&FormatterConfig{}).LayoutOf(InputsSection); got != DefaultLayout {
+		t.Errorf("expected: %s, but got: %s", DefaultLayout, got)
+	}
+}
+
+func TestValidateLayouts(t *testing.T) {
+	cases := []struct {
+		layout   string
+		layouts  map[string]string
+		expected string
+	}{
+		{layout: "grid", layouts: nil, expected: "invalid layout: grid, valid values are [table list]"},
+		{layout: "", layouts: map[string]string{"inputs": "grid"}, expected: "invalid layout: grid, valid values are [table list]"},
+		{layout: "", layouts: map[string]string{"description": "list"}, expected: "unknown section for layouts: description"},
+	}
+
+	for _, tc := range cases {
+		err := ValidateLayouts(tc.layout, tc.layouts)
+		if err == nil || err.Error() != tc.expected {
+			t.Errorf("expected: %s, but got: %v", tc.expected, err)
+		}
+	}
+	if err := ValidateLayouts(ListLayout, map[string]string{InputsSection: TableLayout}); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestValidateFormat(t *testing.T) {
+	if err := ValidateFormat("bogus"); err == nil || err.Error() != "invalid format: bogus, valid values are [markdown json yaml asciidoc html rst]" {
+		t.Errorf("unexpected error: %v", err)
+	}
+	for _, format := range append([]string{""}, AllFormats...) {
+		if err := ValidateFormat(format); err != nil {
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+}
@@ -1,22 +1,81 @@
 package conf
 
+import (
+	"fmt"
+	"strings"
+)
+
 type FormatterConfig struct {
-	Format string
-	Omit   bool
+	Format        string
+	Omit          bool
+	Sections      []string
+	OmitSections  []string
+	HeadingOffset int
+	Titles        map[string]string
+	Columns       map[string][]string
+	// Page renders a full page instead of a fragment for html.
+	Page bool
+	// Layout is the layout of the items for markdown, and Layouts overrides it by section.
+	Layout  string
+	Layouts map[string]string
 }
 
 func DefaultFormatterConfig() *FormatterConfig {
 	return &FormatterConfig{
-		Format: DefaultFormat,
-		Omit:   DefaultOmit,
+		Format:        DefaultFormat,
+		Omit:          DefaultOmit,
+		Sections:      []string{},
+		OmitSections:  []string{},
+		HeadingOffset: DefaultHeadingOffset,
+		Titles:        map[string]string{},
+		Columns:       map[string][]string{},
+		Page:          DefaultPage,
+		Layout:        DefaultLayout,
+		Layouts:       map[string]string{},
 	}
 }
 
 const (
-	DefaultFormat = "markdown"
-	DefaultOmit   = false
+	MarkdownFormat = "markdown"
+	JsonFormat     = "json"
+	AsciidocFormat = "asciidoc"
+	HtmlFormat     = "html"
+	YamlFormat     = "yaml"
+	RstFormat      = "rst"
 )
 
-func (c *FormatterConfig) IsJson() bool {
-	return c.Format == "json"
+// AllFormats is the names of the built-in formats, in the order of the registry in model.
+var AllFormats = []string{MarkdownFormat, JsonFormat, YamlFormat, AsciidocFormat, HtmlFormat, RstFormat}
+
+// ValidateFormat returns an error if the format isn't built-in.
+func ValidateFormat(format string) error {
+	if format != "" && !ContainsSection(AllFormats, format) {
+		return fmt.Errorf("invalid format: %s, valid values are [%s]", format, strings.Join(AllFormats, " "))
+	}
+	return nil
+}
+
+const (
+	DefaultFormat        = MarkdownFormat
+	DefaultOmit          = false
+	DefaultHeadingOffset = 0
+	DefaultPage          = false
+	DefaultLayout        = TableLayout
+)
+
+// SetColumns sets the column keys of the section, keeping other sections.
+func (c *FormatterConfig) SetColumns(section string, keys []string) {
+	columns := map[string][]string{}
+	for key, value := range c.Columns {
+		columns[key] = value
+	}
+	columns[section] = keys
+	c.Columns = columns
+}
+
+func (c *FormatterConfig) Validate() error {
+	if err := validateSectionSettings(c.Sections, c.OmitSections, c.Titles, c.Columns); err != nil {
+		return err
+	}
+	return ValidateLayouts(c.Layout, c.Layouts)
 }
@@ -0,0 +1,50 @@
+package conf
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Layouts of the items in markdown.
+const (
+	// TableLayout renders the items as a table.
+	TableLayout = "table"
+	// ListLayout renders each item as a sub-heading with the description and the attributes.
+	ListLayout = "list"
+)
+
+var AllLayouts = []string{TableLayout, ListLayout}
+
+// LayoutOf returns the layout of the section, or the global layout if the section isn't configured.
+func (c *FormatterConfig) LayoutOf(section string) string {
+	if layout, ok := c.Layouts[section]; ok && layout != "" {
+		return layout
+	}
+	if c.Layout != "" {
+		return c.Layout
+	}
+	return DefaultLayout
+}
+
+// ValidateLayouts returns an error if the layouts contain an unknown layout or an unknown section.
+func ValidateLayouts(layout string, layouts map[string]string) error {
+	if err := validateLayout(layout); err != nil {
+		return err
+	}
+	for section, value := range layouts {
+		if _, ok := AllColumns[section]; !ok {
+			return fmt.Errorf("unknown section for layouts: %s", section)
+		}
+		if err := validateLayout(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateLayout(layout string) error {
+	if layout != "" && !ContainsSection(AllLayouts, layout) {
+		return fmt.Errorf("invalid layout: %s, valid values are [%s]", layout, strings.Join(AllLayouts, " "))
+	}
+	return nil
+}
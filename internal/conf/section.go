@@ -0,0 +1,81 @@
+package conf
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidateSections returns an error if the names contain an unknown section.
+func ValidateSections(names []string) error {
+	for _, name := range names {
+		if !ContainsSection(AllSections, name) {
+			return fmt.Errorf("unknown section: %s, valid values are [%s]", name, strings.Join(AllSections, " "))
+		}
+	}
+	return nil
+}
+
+func validateSectionSettings(sections []string, omitSections []string, titles map[string]string, columns map[string][]string) error {
+	names := append(append([]string{}, sections...), omitSections...)
+	for name := range titles {
+		names = append(names, name)
+	}
+	if err := ValidateSections(names); err != nil {
+		return err
+	}
+	return ValidateColumns(columns)
+}
+
+func ContainsSection(sections []string, name string) bool {
+	for _, section := range sections {
+		if section == name {
+			return true
+		}
+	}
+	return false
+}
+
+const (
+	DescriptionSection = "description"
+	InputsSection      = "inputs"
+	SecretsSection     = "secrets"
+	OutputsSection     = "outputs"
+	PermissionsSection = "permissions"
+)
+
+const (
+	NameColumn        = "name"
+	DescriptionColumn = "description"
+	TypeColumn        = "type"
+	DefaultColumn     = "default"
+	RequiredColumn    = "required"
+	DeprecatedColumn  = "deprecated"
+	ScopeColumn       = "scope"
+	AccessColumn      = "access"
+)
+
+// AllColumns is the column keys available for each section.
+var AllColumns = map[string][]string{
+	InputsSection:      {NameColumn, DescriptionColumn, TypeColumn, DefaultColumn, RequiredColumn, DeprecatedColumn},
+	SecretsSection:     {NameColumn, DescriptionColumn, RequiredColumn},
+	OutputsSection:     {NameColumn, DescriptionColumn},
+	PermissionsSection: {ScopeColumn, AccessColumn},
+}
+
+// ValidateColumns returns an error if the columns contain an unknown section or column.
+func ValidateColumns(columns map[string][]string) error {
+	for section, keys := range columns {
+		available, ok := AllColumns[section]
+		if !ok {
+			return fmt.Errorf("unknown section for columns: %s", section)
+		}
+		for _, key := range keys {
+			if !ContainsSection(available, key) {
+				return fmt.Errorf("unknown %s column: %s, valid values are [%s]", section, key, strings.Join(available, " "))
+			}
+		}
+	}
+	return nil
+}
+
+var AllSections = []string{DescriptionSection, InputsSection, SecretsSection, OutputsSection, PermissionsSection}
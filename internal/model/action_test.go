@@ -0,0 +1,620 @@
+package model
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tmknom/actdocs/internal/conf"
+	"github.com/tmknom/actdocs/internal/util"
+)
+
+func TestSpec_ToJsonWithAction(t *testing.T) {
+	cases := []struct {
+		name     string
+		sut      *Spec
+		expected string
+	}{
+		{
+			name: "empty",
+			sut: &Spec{
+				Document: &Document{
+					Kind:        util.ActionKind,
+					Description: NewNullValue(),
+					Inputs:      []*Input{},
+					Outputs:     []*Output{},
+				},
+			},
+			expected: emptyActionExpectedJson,
+		},
+		{
+			name: "full",
+			sut: &Spec{
+				Document: &Document{
+					Kind:        util.ActionKind,
+					Description: NewNotNullValue("This is a test Custom Action for actdocs."),
+					Inputs: []*Input{
+						{Name: "minimal", Default: NewNullValue(), Description: NewNullValue(), Required: NewNullValue()},
+						{Name: "full", Default: NewNotNullValue("The string"), Description: NewNotNullValue("The input value."), Required: NewNotNullValue("true")},
+					},
+					Outputs: []*Output{
+						{Name: "minimal", Description: NewNullValue()},
+						{Name: "full", Description: NewNotNullValue("The output value.")},
+					},
+				},
+			},
+			expected: fullActionExpectedJson,
+		},
+	}
+
+	for _, tc := range cases {
+		got := tc.sut.ToJson()
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("diff: %s", diff)
+		}
+	}
+}
+
+const emptyActionExpectedJson = `{
+  "description": null,
+  "inputs": [],
+  "outputs": []
+}`
+
+const fullActionExpectedJson = `{
+  "description": "This is a test Custom Action for actdocs.",
+  "inputs": [
+    {
+      "name": "minimal",
+      "default": null,
+      "description": null,
+      "required": null
+    },
+    {
+      "name": "full",
+      "default": "The string",
+      "description": "The input value.",
+      "required": "true"
+    }
+  ],
+  "outputs": [
+    {
+      "name": "minimal",
+      "description": null
+    },
+    {
+      "name": "full",
+      "description": "The output value."
+    }
+  ]
+}`
+
+func TestSpec_ToMarkdownWithAction(t *testing.T) {
+	cases := []struct {
+		name     string
+		config   *conf.FormatterConfig
+		markdown *Spec
+		expected string
+	}{
+		{
+			name:   "omit",
+			config: &conf.FormatterConfig{Format: conf.DefaultFormat, Omit: true},
+			markdown: &Spec{
+				Document: &Document{
+					Kind:        util.ActionKind,
+					Description: NewNullValue(),
+					Inputs:      []*Input{},
+					Outputs:     []*Output{},
+				},
+				Omit: true,
+			},
+			expected: "",
+		},
+		{
+			name:   "empty",
+			config: conf.DefaultFormatterConfig(),
+			markdown: &Spec{
+				Document: &Document{
+					Kind:        util.ActionKind,
+					Description: NewNullValue(),
+					Inputs:      []*Input{},
+					Outputs:     []*Output{},
+				},
+				Omit: false,
+			},
+			expected: emptyActionExpected,
+		},
+		{
+			name:   "full",
+			config: conf.DefaultFormatterConfig(),
+			markdown: &Spec{
+				Document: &Document{
+					Kind:        util.ActionKind,
+					Description: NewNotNullValue("This is a test Custom Action for actdocs."),
+					Inputs: []*Input{
+						{Name: "full-number", Default: NewNotNullValue("5"), Description: NewNotNullValue("The full number value."), Required: NewNotNullValue("false")},
+					},
+					Outputs: []*Output{
+						{Name: "with-description", Description: NewNotNullValue("The Render value with description.")},
+					},
+				},
+				Omit: false,
+			},
+			expected: fullActionExpected,
+		}, {
+			name:   "sections",
+			config: conf.DefaultFormatterConfig(),
+			markdown: &Spec{
+				Document: &Document{
+					Kind:        util.ActionKind,
+					Description: NewNotNullValue("This is a test Custom Action for actdocs."),
+					Inputs:      []*Input{},
+					Outputs: []*Output{
+						{Name: "with-description", Description: NewNotNullValue("The Render value with description.")},
+					},
+				},
+				Sections: []string{"outputs", "secrets", "inputs"},
+			},
+			expected: sectionsActionExpected,
+		},
+		{
+			name:   "omit sections",
+			config: conf.DefaultFormatterConfig(),
+			markdown: &Spec{
+				Document: &Document{
+					Kind:        util.ActionKind,
+					Description: NewNullValue(),
+					Inputs:      []*Input{},
+					Outputs:     []*Output{},
+				},
+				OmitSections: []string{"description", "outputs"},
+			},
+			expected: "## Inputs\n\nN/A",
+		},
+		{
+			name:   "columns",
+			config: conf.DefaultFormatterConfig(),
+			markdown: &Spec{
+				Document: &Document{
+					Kind:        util.ActionKind,
+					Description: NewNullValue(),
+					Inputs: []*Input{
+						{Name: "old", Default: NewNullValue(), Description: NewNotNullValue("The old value."), Required: NewNotNullValue("true"), DeprecationMessage: NewNotNullValue("Use new instead.")},
+					},
+					Outputs: []*Output{},
+				},
+				Sections: []string{"inputs"},
+				Columns:  map[string][]string{"inputs": {"name", "required", "type", "deprecated", "description"}},
+			},
+			expected: columnsActionExpected,
+		},
+	}
+
+	for _, tc := range cases {
+		got := tc.markdown.ToMarkdown()
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("diff: %s", diff)
+		}
+	}
+}
+
+const emptyActionExpected = `## Description
+
+N/A
+
+## Inputs
+
+N/A
+
+## Outputs
+
+N/A`
+
+const fullActionExpected = `## Description
+
+This is a test Custom Action for actdocs.
+
+## Inputs
+
+| Name | Description | Default | Required |
+| :--- | :---------- | :------ | :------: |
+| full-number | The full number value. | ` + "`5`" + ` | no |
+
+## Outputs
+
+| Name | Description |
+| :--- | :---------- |
+| with-description | The Render value with description. |`
+
+const sectionsActionExpected = `## Outputs
+
+| Name | Description |
+| :--- | :---------- |
+| with-description | The Render value with description. |
+
+## Inputs
+
+N/A`
+
+const columnsActionExpected = `## Inputs
+
+| Name | Required | Deprecated | Description |
+| :--- | :------: | :--------- | :---------- |
+| old | yes | Use new instead. | The old value. |`
+
+func TestSpec_ToDescriptionMarkdownWithAction(t *testing.T) {
+	cases := []struct {
+		name        string
+		description *util.NullString
+		omit        bool
+		expected    string
+	}{
+		{
+			name:        "omit",
+			description: NewNullValue(),
+			omit:        true,
+			expected:    "",
+		},
+		{
+			name:        "null value",
+			description: NewNullValue(),
+			omit:        false,
+			expected:    "## Description\n\nN/A",
+		},
+		{
+			name:        "valid value",
+			description: NewNotNullValue("The valid."),
+			omit:        false,
+			expected:    "## Description\n\nThe valid.",
+		},
+	}
+
+	for _, tc := range cases {
+		spec := &Spec{Document: &Document{Kind: util.ActionKind, Description: tc.description}, Omit: tc.omit}
+		got := spec.ToDescriptionMarkdown()
+
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("diff: %s", diff)
+		}
+	}
+}
+
+func TestSpec_ToInputsMarkdownWithAction(t *testing.T) {
+	cases := []struct {
+		name     string
+		inputs   []*Input
+		omit     bool
+		expected string
+	}{
+		{
+			name:     "empty",
+			inputs:   []*Input{},
+			omit:     false,
+			expected: "## Inputs\n\nN/A",
+		},
+		{
+			name: "minimal",
+			inputs: []*Input{
+				{Name: "minimal", Default: NewNullValue(), Description: NewNullValue(), Required: NewNullValue()},
+			},
+			omit:     false,
+			expected: "## Inputs\n\n| Name | Description | Default | Required |\n| :--- | :---------- | :------ | :------: |\n| minimal |  | n/a | no |",
+		},
+		{
+			name: "single",
+			inputs: []*Input{
+				{Name: "single", Default: NewNotNullValue("5"), Description: NewNotNullValue("The number."), Required: NewNotNullValue("true")},
+			},
+			omit:     false,
+			expected: "## Inputs\n\n| Name | Description | Default | Required |\n| :--- | :---------- | :------ | :------: |\n| single | The number. | `5` | yes |",
+		},
+		{
+			name: "multiple",
+			inputs: []*Input{
+				{Name: "multiple-1", Default: NewNotNullValue("The string"), Description: NewNotNullValue("1"), Required: NewNotNullValue("false")},
+				{Name: "multiple-2", Default: NewNotNullValue("true"), Description: NewNotNullValue("2"), Required: NewNotNullValue("true")},
+			},
+			omit:     false,
+			expected: "## Inputs\n\n| Name | Description | Default | Required |\n| :--- | :---------- | :------ | :------: |\n| multiple-1 | 1 | `The string` | no |\n| multiple-2 | 2 | `true` | yes |",
+		},
+	}
+
+	for _, tc := range cases {
+		spec := &Spec{Document: &Document{Kind: util.ActionKind, Inputs: tc.inputs}, Omit: tc.omit}
+		got := spec.ToInputsMarkdown()
+
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("diff: %s", diff)
+		}
+	}
+}
+
+func TestSpec_ToOutputsMarkdownWithAction(t *testing.T) {
+	cases := []struct {
+		name     string
+		outputs  []*Output
+		omit     bool
+		expected string
+	}{
+		{
+			name:     "empty",
+			outputs:  []*Output{},
+			omit:     false,
+			expected: "## Outputs\n\nN/A",
+		},
+		{
+			name: "minimal",
+			outputs: []*Output{
+				{Name: "minimal", Description: NewNullValue()},
+			},
+			omit:     false,
+			expected: "## Outputs\n\n| Name | Description |\n| :--- | :---------- |\n| minimal |  |",
+		},
+		{
+			name: "single",
+			outputs: []*Output{
+				{Name: "single", Description: NewNotNullValue("The test description.")},
+			},
+			omit:     false,
+			expected: "## Outputs\n\n| Name | Description |\n| :--- | :---------- |\n| single | The test description. |",
+		},
+		{
+			name: "multiple",
+			outputs: []*Output{
+				{Name: "multiple-1", Description: NewNotNullValue("1")},
+				{Name: "multiple-2", Description: NewNotNullValue("2")},
+			},
+			omit:     false,
+			expected: "## Outputs\n\n| Name | Description |\n| :--- | :---------- |\n| multiple-1 | 1 |\n| multiple-2 | 2 |",
+		},
+	}
+
+	for _, tc := range cases {
+		spec := &Spec{Document: &Document{Kind: util.ActionKind, Outputs: tc.outputs}, Omit: tc.omit}
+		got := spec.ToOutputsMarkdown()
+
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("diff: %s", diff)
+		}
+	}
+}
+
+func TestInputsColumnsWithAction(t *testing.T) {
+	cases := []struct {
+		name     string
+		sut      *Input
+		expected string
+	}{
+		{
+			name: "single line",
+			sut: &Input{
+				Name:        "single-line",
+				Default:     NewNotNullValue("Default value"),
+				Description: NewNotNullValue("The test description."),
+				Required:    NewNotNullValue("false"),
+			},
+			expected: "| single-line | The test description. | `Default value` | no |",
+		},
+		{
+			name: "multi line",
+			sut: &Input{
+				Name:        "multi-line",
+				Default:     NewNotNullValue("{\n  \"key\": \"value\"\n}"),
+				Description: NewNotNullValue("one\ntwo\nthree"),
+				Required:    NewNotNullValue("true"),
+			},
+			expected: "| multi-line | <pre>one<br>two<br>three</pre> | <pre>{<br>  \"key\": \"value\"<br>}</pre> | yes |",
+		},
+	}
+
+	for _, tc := range cases {
+		got := util.TableRow(util.SelectColumns(InputsColumns, ActionProfile.DefaultColumns[conf.InputsSection]), tc.sut)
+
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("diff: %s", diff)
+		}
+	}
+}
+
+func TestOutputsColumnsWithAction(t *testing.T) {
+	cases := []struct {
+		name     string
+		sut      *Output
+		expected string
+	}{
+		{
+			name: "single line",
+			sut: &Output{
+				Name:        "single-line",
+				Description: NewNotNullValue("The test description."),
+			},
+			expected: "| single-line | The test description. |",
+		},
+		{
+			name: "multi line",
+			sut: &Output{
+				Name:        "multi-line",
+				Description: NewNotNullValue("one\ntwo\nthree"),
+			},
+			expected: "| multi-line | <pre>one<br>two<br>three</pre> |",
+		},
+	}
+
+	for _, tc := range cases {
+		got := util.TableRow(util.SelectColumns(OutputsColumns, ActionProfile.DefaultColumns[conf.OutputsSection]), tc.sut)
+
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("diff: %s", diff)
+		}
+	}
+}
+
+func TestSpec_RenderDirectiveWithAction(t *testing.T) {
+	cases := []struct {
+		name     string
+		spec     *Spec
+		template string
+		expected string
+	}{
+		{
+			name: "all",
+			spec: &Spec{
+				Document: &Document{
+					Kind:        util.ActionKind,
+					Description: NewNotNullValue("This is a test Custom Action for actdocs."),
+					Inputs: []*Input{
+						{Name: "full-number", Default: NewNotNullValue("5"), Description: NewNotNullValue("The full number value."), Required: NewNotNullValue("false"), DeprecationMessage: NewNullValue()},
+					},
+					Outputs: []*Output{
+						{Name: "with-description", Description: NewNotNullValue("The Render value with description.")},
+					},
+				},
+			},
+			template: testBaseDir + "testdata/output.md",
+			expected: fullActionRenderExpected,
+		},
+		{
+			name: "sections",
+			spec: &Spec{
+				Document: &Document{
+					Kind:        util.ActionKind,
+					Description: NewNotNullValue("This is a test Custom Action for actdocs."),
+					Inputs: []*Input{
+						{Name: "full-number", Default: NewNotNullValue("5"), Description: NewNotNullValue("The full number value."), Required: NewNotNullValue("false"), DeprecationMessage: NewNullValue()},
+					},
+					Outputs: []*Output{
+						{Name: "with-description", Description: NewNotNullValue("The Render value with description.")},
+					},
+				},
+			},
+			template: testBaseDir + "testdata/inject-sections.md",
+			expected: sectionsActionRenderExpected,
+		},
+		{
+			name: "attributes",
+			spec: &Spec{
+				Document: &Document{
+					Kind:        util.ActionKind,
+					Description: NewNotNullValue("This is a test Custom Action for actdocs."),
+					Inputs: []*Input{
+						{Name: "full-number", Default: NewNotNullValue("5"), Description: NewNotNullValue("The full number value."), Required: NewNotNullValue("false"), DeprecationMessage: NewNullValue()},
+					},
+					Outputs: []*Output{},
+				},
+				HeadingOffset: 1,
+				Titles:        map[string]string{"outputs": "Action outputs"},
+			},
+			template: testBaseDir + "testdata/inject-attributes.md",
+			expected: attributesActionRenderExpected,
+		},
+	}
+
+	for _, tc := range cases {
+		template, err := os.Open(tc.template)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		defer func(file *os.File) { err = file.Close() }(template)
+
+		got, err := render(template, tc.spec)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.name, err)
+		}
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("%s: diff: %s", tc.name, diff)
+		}
+	}
+}
+
+const fullActionRenderExpected = `# Output test
+
+## Header
+
+This is a header.
+
+<!-- actdocs start -->
+
+## Description
+
+This is a test Custom Action for actdocs.
+
+## Inputs
+
+| Name | Description | Default | Required |
+| :--- | :---------- | :------ | :------: |
+| full-number | The full number value. | ` + "`5`" + ` | no |
+
+## Outputs
+
+| Name | Description |
+| :--- | :---------- |
+| with-description | The Render value with description. |
+
+<!-- actdocs end -->
+
+## Footer
+
+This is a footer.
+`
+
+const sectionsActionRenderExpected = `# Output test
+
+## Header
+
+This is a header.
+
+<!-- actdocs description start -->
+
+## Description
+
+This is a test Custom Action for actdocs.
+
+<!-- actdocs description end -->
+
+<!-- actdocs inputs start -->
+
+## Inputs
+
+| Name | Description | Default | Required |
+| :--- | :---------- | :------ | :------: |
+| full-number | The full number value. | ` + "`5`" + ` | no |
+
+<!-- actdocs inputs end -->
+
+<!-- actdocs outputs start -->
+
+## Outputs
+
+| Name | Description |
+| :--- | :---------- |
+| with-description | The Render value with description. |
+
+<!-- actdocs outputs end -->
+
+## Footer
+
+This is a footer.
+`
+
+const attributesActionRenderExpected = `# Output test
+
+## Usage
+
+<!-- actdocs inputs start heading-offset=1 title="Action inputs" -->
+
+### Action inputs
+
+| Name | Description | Default | Required |
+| :--- | :---------- | :------ | :------: |
+| full-number | The full number value. | ` + "`5`" + ` | no |
+
+<!-- actdocs inputs end -->
+
+<!-- actdocs outputs start -->
+
+### Action outputs
+
+N/A
+
+<!-- actdocs outputs end -->
+`
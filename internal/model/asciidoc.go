@@ -0,0 +1,125 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/tmknom/actdocs/internal/conf"
+	"github.com/tmknom/actdocs/internal/util"
+)
+
+type AsciidocFormatter struct{}
+
+func (f *AsciidocFormatter) Format(spec *Spec) string {
+	return spec.ToAsciidoc()
+}
+
+func (f *AsciidocFormatter) FormatSection(spec *Spec, section string) string {
+	return spec.toSectionAsciidoc(section)
+}
+
+func (s *Spec) ToAsciidoc() string {
+	return s.joinSections(s.toSectionAsciidoc)
+}
+
+func (s *Spec) toSectionAsciidoc(section string) string {
+	switch section {
+	case conf.DescriptionSection:
+		return s.toDescriptionAsciidoc()
+	case conf.InputsSection:
+		return toTableAsciidoc(s, section, InputFields, s.Inputs)
+	case conf.SecretsSection:
+		return toTableAsciidoc(s, section, SecretFields, s.Secrets)
+	case conf.OutputsSection:
+		return toTableAsciidoc(s, section, OutputFields, s.Outputs)
+	case conf.PermissionsSection:
+		return toTableAsciidoc(s, section, PermissionFields, s.Permissions)
+	}
+	return ""
+}
+
+func (s *Spec) toDescriptionAsciidoc() string {
+	if s.omitted(conf.DescriptionSection) && !s.Description.IsValid() {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString(util.AsciidocSyntax.Heading(s.headingLevel(), s.title(conf.DescriptionSection)))
+	sb.WriteString("\n\n")
+	sb.WriteString(strings.TrimSpace(s.Description.StringOrUpperNA()))
+	return sb.String()
+}
+
+// toTableAsciidoc returns the section having the table of the items, or "N/A" if the items are empty.
+func toTableAsciidoc[T any](s *Spec, section string, fields []*Field[T], items []T) string {
+	if s.omitted(section) && len(items) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString(util.AsciidocSyntax.Heading(s.headingLevel(), s.title(section)))
+	sb.WriteString("\n\n")
+	if len(items) == 0 {
+		sb.WriteString(util.UpperNAString)
+		return sb.String()
+	}
+
+	selected := selectFields(s, section, fields)
+	//goland:noinspection GoPreferNilSlice
+	cols := []string{}
+	//goland:noinspection GoPreferNilSlice
+	header := []string{}
+	for _, field := range selected {
+		if field.Style == FlagStyle {
+			cols = append(cols, "^1")
+		} else {
+			cols = append(cols, "1")
+		}
+		header = append(header, "|"+field.Title)
+	}
+	sb.WriteString(`[cols="` + strings.Join(cols, ",") + `",options="header"]` + "\n")
+	sb.WriteString(asciidocTableDelimiter + "\n")
+	sb.WriteString(strings.Join(header, " ") + "\n")
+	for _, item := range items {
+		sb.WriteString("\n")
+		for _, field := range selected {
+			sb.WriteString(asciidocCell(field.Style, field.Value(item)))
+			sb.WriteString("\n")
+		}
+	}
+	sb.WriteString(asciidocTableDelimiter)
+	return sb.String()
+}
+
+// asciidocCell returns the table cell of the value, where multi-line values are rendered as AsciiDoc cells.
+func asciidocCell(style FieldStyle, value *util.NullString) string {
+	switch style {
+	case TextStyle:
+		text := strings.TrimSpace(value.Value)
+		if strings.Contains(text, "\n") {
+			return "a|" + escapeAsciidocCell(text)
+		}
+		return "|" + escapeAsciidocCell(text)
+	case CodeStyle:
+		if !value.IsValid() {
+			return "|" + util.LowerNAString
+		}
+		if value.Value == "" {
+			return "|`{empty}`"
+		}
+		if code := strings.TrimRight(value.Value, "\n"); strings.Contains(code, "\n") {
+			return "a|\n" + asciidocLiteralDelimiter + "\n" + escapeAsciidocCell(code) + "\n" + asciidocLiteralDelimiter
+		}
+		return "|`+" + escapeAsciidocCell(value.Value) + "+`"
+	case FlagStyle:
+		return "|" + value.YesOrNo()
+	}
+	return "|" + escapeAsciidocCell(value.Value)
+}
+
+// escapeAsciidocCell escapes the cell separators in the value.
+func escapeAsciidocCell(value string) string {
+	return strings.ReplaceAll(value, "|", `\|`)
+}
+
+const asciidocTableDelimiter = "|==="
+const asciidocLiteralDelimiter = "...."
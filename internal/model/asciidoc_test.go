@@ -0,0 +1,139 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tmknom/actdocs/internal/conf"
+	"github.com/tmknom/actdocs/internal/util"
+)
+
+func TestSpec_ToAsciidoc(t *testing.T) {
+	action := NewDocument(util.ActionKind)
+	action.Description = NewNotNullValue("This is a test Custom Action.")
+	action.Inputs = []*Input{
+		{Name: "single", Default: NewNotNullValue("a|b"), Description: NewNotNullValue("The single line."), Required: NewNotNullValue("true")},
+		{Name: "multi", Default: NewNotNullValue("foo\nbar\n"), Description: NewNotNullValue("The first line.\nThe second line."), Required: NewNullValue()},
+		{Name: "empty", Default: NewNotNullValue(""), Description: NewNullValue(), Required: NewNotNullValue("false")},
+		{Name: "null", Default: NewNullValue(), Description: NewNullValue(), Required: NewNullValue()},
+	}
+
+	workflow := NewDocument(util.WorkflowKind)
+	workflow.Permissions = []*Permission{NewPermission("contents", "write")}
+
+	cases := []struct {
+		name     string
+		sut      *Spec
+		expected string
+	}{
+		{
+			name:     "empty action",
+			sut:      NewSpec(NewDocument(util.ActionKind), conf.DefaultFormatterConfig()),
+			expected: emptyActionExpectedAsciidoc,
+		},
+		{
+			name:     "full action",
+			sut:      NewSpec(action, conf.DefaultFormatterConfig()),
+			expected: fullActionExpectedAsciidoc,
+		},
+		{
+			name:     "omit action",
+			sut:      NewSpec(NewDocument(util.ActionKind), &conf.FormatterConfig{Omit: true}),
+			expected: "",
+		},
+		{
+			name:     "workflow permissions",
+			sut:      NewSpec(workflow, &conf.FormatterConfig{Sections: []string{conf.PermissionsSection}, HeadingOffset: 1}),
+			expected: permissionsWorkflowExpectedAsciidoc,
+		},
+	}
+
+	for _, tc := range cases {
+		got := tc.sut.ToAsciidoc()
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("%s: diff: %s", tc.name, diff)
+		}
+	}
+}
+
+const emptyActionExpectedAsciidoc = `== Description
+
+N/A
+
+== Inputs
+
+N/A
+
+== Outputs
+
+N/A`
+
+const fullActionExpectedAsciidoc = `== Description
+
+This is a test Custom Action.
+
+== Inputs
+
+[cols="1,1,1,^1",options="header"]
+|===
+|Name |Description |Default |Required
+
+|single
+|The single line.
+|` + "`+a\\|b+`" + `
+|yes
+
+|multi
+a|The first line.
+The second line.
+a|
+....
+foo
+bar
+....
+|no
+
+|empty
+|
+|` + "`{empty}`" + `
+|no
+
+|null
+|
+|n/a
+|no
+|===
+
+== Outputs
+
+N/A`
+
+const permissionsWorkflowExpectedAsciidoc = `=== Permissions
+
+[cols="1,1",options="header"]
+|===
+|Scope |Access
+
+|contents
+|write
+|===`
+
+func TestSpec_RenderDirectiveWithAsciidoc(t *testing.T) {
+	document := NewDocument(util.ActionKind)
+	document.Outputs = []*Output{{Name: "result", Description: NewNotNullValue("The result.")}}
+	spec := NewSpec(document, conf.DefaultFormatterConfig())
+
+	template := "= Output test\n\n// actdocs outputs start heading-offset=1\n// actdocs outputs end\n"
+	got, err := util.NewSyntaxInjector(strings.NewReader(template), util.AsciidocSyntax, conf.AllSections, func(string) (util.Document, error) {
+		return spec, nil
+	}).Inject()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := "= Output test\n\n// actdocs outputs start heading-offset=1\n\n=== Outputs\n\n[cols=\"1,1\",options=\"header\"]\n|===\n|Name |Description\n\n|result\n|The result.\n|===\n\n// actdocs outputs end\n"
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("diff: %s", diff)
+	}
+}
@@ -0,0 +1,126 @@
+package model
+
+import (
+	"github.com/tmknom/actdocs/internal/conf"
+	"github.com/tmknom/actdocs/internal/util"
+)
+
+// Document is the model of Custom Actions and Reusable Workflows, which both parsers produce.
+// Secrets and Permissions are always empty for Custom Actions,
+// and Description and Runtime are always empty for Reusable Workflows.
+type Document struct {
+	Kind        string
+	Name        *util.NullString
+	Description *util.NullString
+	Inputs      []*Input
+	Secrets     []*Secret
+	Outputs     []*Output
+	Permissions []*Permission
+	// Runtime is "runs.using" of Custom Actions such as "composite", or empty if not specified.
+	Runtime string
+}
+
+func NewDocument(kind string) *Document {
+	return &Document{
+		Kind:        kind,
+		Name:        util.DefaultNullString,
+		Description: util.DefaultNullString,
+		Inputs:      []*Input{},
+		Secrets:     []*Secret{},
+		Outputs:     []*Output{},
+		Permissions: []*Permission{},
+	}
+}
+
+// Input is the input of Custom Actions and Reusable Workflows.
+// Type is always null for Custom Actions, and DeprecationMessage is always null for Reusable Workflows.
+type Input struct {
+	Name               string
+	Default            *util.NullString
+	Description        *util.NullString
+	Required           *util.NullString
+	Type               *util.NullString
+	DeprecationMessage *util.NullString
+}
+
+func NewInput(name string) *Input {
+	return &Input{
+		Name:               name,
+		Default:            util.DefaultNullString,
+		Description:        util.DefaultNullString,
+		Required:           util.DefaultNullString,
+		Type:               util.DefaultNullString,
+		DeprecationMessage: util.DefaultNullString,
+	}
+}
+
+type Secret struct {
+	Name        string           `json:"name" yaml:"name"`
+	Description *util.NullString `json:"description" yaml:"description"`
+	Required    *util.NullString `json:"required" yaml:"required"`
+}
+
+func NewSecret(name string) *Secret {
+	return &Secret{
+		Name:        name,
+		Description: util.DefaultNullString,
+		Required:    util.DefaultNullString,
+	}
+}
+
+type Output struct {
+	Name        string           `json:"name" yaml:"name"`
+	Description *util.NullString `json:"description" yaml:"description"`
+}
+
+func NewOutput(name string) *Output {
+	return &Output{
+		Name:        name,
+		Description: util.DefaultNullString,
+	}
+}
+
+type Permission struct {
+	Scope  string `json:"scope" yaml:"scope"`
+	Access string `json:"access" yaml:"access"`
+}
+
+func NewPermission(scope string, access string) *Permission {
+	return &Permission{
+		Scope:  scope,
+		Access: access,
+	}
+}
+
+// Sort returns a copy of the document with the items sorted.
+func (d *Document) Sort(config *conf.SortConfig) *Document {
+	result := *d
+	result.Inputs = append([]*Input{}, d.Inputs...)
+	result.Secrets = append([]*Secret{}, d.Secrets...)
+	result.Outputs = append([]*Output{}, d.Outputs...)
+	result.Permissions = append([]*Permission{}, d.Permissions...)
+
+	switch {
+	case config.Sort:
+		result.Inputs = util.SortByRequiredAndName(result.Inputs, inputName, inputRequired)
+		result.Secrets = util.SortByRequiredAndName(result.Secrets, secretName, secretRequired)
+		util.SortByName(result.Outputs, outputName)
+		util.SortByName(result.Permissions, permissionScope)
+	case config.SortByName:
+		util.SortByName(result.Inputs, inputName)
+		util.SortByName(result.Secrets, secretName)
+		util.SortByName(result.Outputs, outputName)
+		util.SortByName(result.Permissions, permissionScope)
+	case config.SortByRequired:
+		util.SortByRequired(result.Inputs, inputRequired)
+		util.SortByRequired(result.Secrets, secretRequired)
+	}
+	return &result
+}
+
+func inputName(input *Input) string                 { return input.Name }
+func inputRequired(input *Input) bool               { return input.Required.IsTrue() }
+func secretName(secret *Secret) string              { return secret.Name }
+func secretRequired(secret *Secret) bool            { return secret.Required.IsTrue() }
+func outputName(output *Output) string              { return output.Name }
+func permissionScope(permission *Permission) string { return permission.Scope }
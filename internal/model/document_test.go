@@ -0,0 +1,112 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tmknom/actdocs/internal/conf"
+	"github.com/tmknom/actdocs/internal/util"
+)
+
+func TestDocument_Sort(t *testing.T) {
+	cases := []struct {
+		name        string
+		config      *conf.SortConfig
+		inputs      []string
+		secrets     []string
+		outputs     []string
+		permissions []string
+	}{
+		{
+			name:        "default",
+			config:      conf.DefaultSortConfig(),
+			inputs:      []string{"b", "a", "c"},
+			secrets:     []string{"y", "x"},
+			outputs:     []string{"q", "p"},
+			permissions: []string{"pages", "contents"},
+		},
+		{
+			name:        "sort",
+			config:      &conf.SortConfig{Sort: true},
+			inputs:      []string{"c", "a", "b"},
+			secrets:     []string{"x", "y"},
+			outputs:     []string{"p", "q"},
+			permissions: []string{"contents", "pages"},
+		},
+		{
+			name:        "sort by name",
+			config:      &conf.SortConfig{SortByName: true},
+			inputs:      []string{"a", "b", "c"},
+			secrets:     []string{"x", "y"},
+			outputs:     []string{"p", "q"},
+			permissions: []string{"contents", "pages"},
+		},
+		{
+			name:        "sort by required",
+			config:      &conf.SortConfig{SortByRequired: true},
+			inputs:      []string{"c", "b", "a"},
+			secrets:     []string{"x", "y"},
+			outputs:     []string{"q", "p"},
+			permissions: []string{"pages", "contents"},
+		},
+	}
+
+	for _, tc := range cases {
+		sut := NewDocument(util.WorkflowKind)
+		sut.Inputs = []*Input{
+			{Name: "b", Required: NewNullValue()},
+			{Name: "a", Required: NewNotNullValue("false")},
+			{Name: "c", Required: NewNotNullValue("true")},
+		}
+		sut.Secrets = []*Secret{
+			{Name: "y", Required: NewNullValue()},
+			{Name: "x", Required: NewNotNullValue("true")},
+		}
+		sut.Outputs = []*Output{{Name: "q"}, {Name: "p"}}
+		sut.Permissions = []*Permission{{Scope: "pages"}, {Scope: "contents"}}
+
+		got := sut.Sort(tc.config)
+		if diff := cmp.Diff(names(got.Inputs, inputName), tc.inputs); diff != "" {
+			t.Errorf("%s: inputs diff: %s", tc.name, diff)
+		}
+		if diff := cmp.Diff(names(got.Secrets, secretName), tc.secrets); diff != "" {
+			t.Errorf("%s: secrets diff: %s", tc.name, diff)
+		}
+		if diff := cmp.Diff(names(got.Outputs, outputName), tc.outputs); diff != "" {
+			t.Errorf("%s: outputs diff: %s", tc.name, diff)
+		}
+		if diff := cmp.Diff(names(got.Permissions, permissionScope), tc.permissions); diff != "" {
+			t.Errorf("%s: permissions diff: %s", tc.name, diff)
+		}
+		if diff := cmp.Diff(names(sut.Inputs, inputName), []string{"b", "a", "c"}); diff != "" {
+			t.Errorf("%s: expected the original document is kept as is: %s", tc.name, diff)
+		}
+	}
+}
+
+func TestDocument_SortByRequired(t *testing.T) {
+	sut := NewDocument(util.WorkflowKind)
+	for _, name := range []string{"one", "two", "three"} {
+		sut.Inputs = append(sut.Inputs, &Input{Name: name, Required: NewNotNullValue("true")})
+		sut.Secrets = append(sut.Secrets, &Secret{Name: name, Required: NewNotNullValue("true")})
+	}
+	sut.Inputs = append(sut.Inputs, &Input{Name: "four", Required: NewNullValue()}, &Input{Name: "five", Required: NewNotNullValue("true")}, &Input{Name: "six", Required: NewNotNullValue("false")})
+	sut.Secrets = append([]*Secret{{Name: "zero", Required: NewNotNullValue("false")}}, sut.Secrets...)
+
+	got := sut.Sort(&conf.SortConfig{SortByRequired: true})
+	if diff := cmp.Diff(names(got.Inputs, inputName), []string{"one", "two", "three", "five", "four", "six"}); diff != "" {
+		t.Errorf("inputs diff: %s", diff)
+	}
+	if diff := cmp.Diff(names(got.Secrets, secretName), []string{"one", "two", "three", "zero"}); diff != "" {
+		t.Errorf("secrets diff: %s", diff)
+	}
+}
+
+func names[T any](items []T, name func(T) string) []string {
+	//goland:noinspection GoPreferNilSlice
+	result := []string{}
+	for _, item := range items {
+		result = append(result, name(item))
+	}
+	return result
+}
@@ -0,0 +1,74 @@
+package model
+
+import (
+	"github.com/tmknom/actdocs/internal/conf"
+	"github.com/tmknom/actdocs/internal/util"
+)
+
+// Field is the attribute of the items such as the default of the inputs, rendered by each formatter.
+type Field[T any] struct {
+	Key   string
+	Title string
+	Style FieldStyle
+	Value func(item T) *util.NullString
+}
+
+// FieldStyle is how the formatters render the value of the field.
+type FieldStyle int
+
+const (
+	// PlainStyle is the value such as the name, rendered as is.
+	PlainStyle FieldStyle = iota
+	// TextStyle is the free text such as the description, rendered as empty if null.
+	TextStyle
+	// CodeStyle is the literal such as the default, rendered as code or "n/a" if null.
+	CodeStyle
+	// FlagStyle is the boolean such as the required, rendered as "yes" or "no".
+	FlagStyle
+)
+
+var InputFields = []*Field[*Input]{
+	{Key: conf.NameColumn, Title: "Name", Style: PlainStyle, Value: func(s *Input) *util.NullString { return plain(s.Name) }},
+	{Key: conf.DescriptionColumn, Title: "Description", Style: TextStyle, Value: func(s *Input) *util.NullString { return s.Description }},
+	{Key: conf.TypeColumn, Title: "Type", Style: CodeStyle, Value: func(s *Input) *util.NullString { return s.Type }},
+	{Key: conf.DefaultColumn, Title: "Default", Style: CodeStyle, Value: func(s *Input) *util.NullString { return s.Default }},
+	{Key: conf.RequiredColumn, Title: "Required", Style: FlagStyle, Value: func(s *Input) *util.NullString { return s.Required }},
+	{Key: conf.DeprecatedColumn, Title: "Deprecated", Style: TextStyle, Value: func(s *Input) *util.NullString { return s.DeprecationMessage }},
+}
+
+var SecretFields = []*Field[*Secret]{
+	{Key: conf.NameColumn, Title: "Name", Style: PlainStyle, Value: func(s *Secret) *util.NullString { return plain(s.Name) }},
+	{Key: conf.DescriptionColumn, Title: "Description", Style: TextStyle, Value: func(s *Secret) *util.NullString { return s.Description }},
+	{Key: conf.RequiredColumn, Title: "Required", Style: FlagStyle, Value: func(s *Secret) *util.NullString { return s.Required }},
+}
+
+var OutputFields = []*Field[*Output]{
+	{Key: conf.NameColumn, Title: "Name", Style: PlainStyle, Value: func(s *Output) *util.NullString { return plain(s.Name) }},
+	{Key: conf.DescriptionColumn, Title: "Description", Style: TextStyle, Value: func(s *Output) *util.NullString { return s.Description }},
+}
+
+var PermissionFields = []*Field[*Permission]{
+	{Key: conf.ScopeColumn, Title: "Scope", Style: PlainStyle, Value: func(s *Permission) *util.NullString { return plain(s.Scope) }},
+	{Key: conf.AccessColumn, Title: "Access", Style: PlainStyle, Value: func(s *Permission) *util.NullString { return plain(s.Access) }},
+}
+
+// selectFields returns the fields of the column keys, ignoring fields not supported by the kind.
+func selectFields[T any](s *Spec, section string, fields []*Field[T]) []*Field[T] {
+	//goland:noinspection GoPreferNilSlice
+	result := []*Field[T]{}
+	for _, key := range s.columnKeys(section) {
+		if !conf.ContainsSection(s.profile().Columns[section], key) {
+			continue
+		}
+		for _, field := range fields {
+			if field.Key == key {
+				result = append(result, field)
+			}
+		}
+	}
+	return result
+}
+
+func plain(value string) *util.NullString {
+	return util.NewNullString(&value)
+}
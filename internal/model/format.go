@@ -0,0 +1,90 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tmknom/actdocs/internal/conf"
+)
+
+// Formatter formats the spec in the format such as markdown.
+type Formatter interface {
+	Format(spec *Spec) string
+}
+
+// SectionFormatter is the formatter that also formats each section, which can be injected into the directives.
+type SectionFormatter interface {
+	Formatter
+	FormatSection(spec *Spec, section string) string
+}
+
+// FormatterRegistry is the formatters by the format name in registration order.
+type FormatterRegistry struct {
+	names      []string
+	formatters map[string]Formatter
+}
+
+func NewFormatterRegistry() *FormatterRegistry {
+	return &FormatterRegistry{
+		names:      []string{},
+		formatters: map[string]Formatter{},
+	}
+}
+
+// Register registers the formatter, replacing the formatter registered with the same name.
+func (r *FormatterRegistry) Register(name string, formatter Formatter) {
+	if _, ok := r.formatters[name]; !ok {
+		r.names = append(r.names, name)
+	}
+	r.formatters[name] = formatter
+}
+
+// Lookup returns the formatter of the name, or returns error if not registered.
+func (r *FormatterRegistry) Lookup(name string) (Formatter, error) {
+	formatter, ok := r.formatters[name]
+	if !ok {
+		return nil, fmt.Errorf("invalid format: %s, valid values are [%s]", name, strings.Join(r.names, " "))
+	}
+	return formatter, nil
+}
+
+// LookupSectionFormatter returns the formatter of the name, or returns error if it can't format each section.
+func (r *FormatterRegistry) LookupSectionFormatter(name string) (SectionFormatter, error) {
+	formatter, err := r.Lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	sectionFormatter, ok := formatter.(SectionFormatter)
+	if !ok {
+		return nil, fmt.Errorf("invalid format: %s can't be injected", name)
+	}
+	return sectionFormatter, nil
+}
+
+// Names returns the registered format names in registration order.
+func (r *FormatterRegistry) Names() []string {
+	return append([]string{}, r.names...)
+}
+
+// Formatters is the registry of the built-in formatters.
+var Formatters = newDefaultFormatterRegistry()
+
+func newDefaultFormatterRegistry() *FormatterRegistry {
+	registry := NewFormatterRegistry()
+	registry.Register(conf.MarkdownFormat, &MarkdownFormatter{})
+	registry.Register(conf.JsonFormat, &JsonFormatter{})
+	registry.Register(conf.YamlFormat, &YamlFormatter{})
+	registry.Register(conf.AsciidocFormat, &AsciidocFormatter{})
+	registry.Register(conf.HtmlFormat, &HtmlFormatter{})
+	registry.Register(conf.RstFormat, &RstFormatter{})
+	return registry
+}
+
+// Format returns the spec in the format registered in Formatters.
+func (s *Spec) Format(format string) (string, error) {
+	formatter, err := Formatters.Lookup(format)
+	if err != nil {
+		return "", err
+	}
+	return formatter.Format(s), nil
+}
@@ -0,0 +1,85 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tmknom/actdocs/internal/conf"
+	"github.com/tmknom/actdocs/internal/util"
+)
+
+func TestSpec_Format(t *testing.T) {
+	document := NewDocument(util.ActionKind)
+	document.Outputs = []*Output{{Name: "result", Description: NewNotNullValue("The result.")}}
+	sut := NewSpec(document, &conf.FormatterConfig{Sections: []string{conf.OutputsSection}})
+
+	cases := []struct {
+		format   string
+		expected string
+	}{
+		{
+			format:   conf.MarkdownFormat,
+			expected: "## Outputs\n\n| Name | Description |\n| :--- | :---------- |\n| result | The result. |",
+		},
+		{
+			format:   conf.JsonFormat,
+			expected: "{\n  \"description\": null,\n  \"inputs\": [],\n  \"outputs\": [\n    {\n      \"name\": \"result\",\n      \"description\": \"The result.\"\n    }\n  ]\n}",
+		},
+	}
+
+	for _, tc := range cases {
+		got, err := sut.Format(tc.format)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.format, err)
+		}
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("%s: diff: %s", tc.format, diff)
+		}
+	}
+}
+
+func TestSpec_FormatWithError(t *testing.T) {
+	sut := NewSpec(NewDocument(util.ActionKind), conf.DefaultFormatterConfig())
+	_, err := sut.Format("unknown")
+	expected := "invalid format: unknown, valid values are [markdown json yaml asciidoc html rst]"
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected: %s, but got: %v", expected, err)
+	}
+}
+
+func TestFormatterRegistry_Register(t *testing.T) {
+	sut := NewFormatterRegistry()
+	sut.Register("foo", &JsonFormatter{})
+	sut.Register("bar", &JsonFormatter{})
+	sut.Register("foo", &MarkdownFormatter{})
+
+	if diff := cmp.Diff(sut.Names(), []string{"foo", "bar"}); diff != "" {
+		t.Errorf("diff: %s", diff)
+	}
+
+	got, err := sut.Lookup("foo")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, ok := got.(*MarkdownFormatter); !ok {
+		t.Errorf("expected the formatter registered later, but got: %#v", got)
+	}
+}
+
+func TestFormatterRegistry_LookupSectionFormatter(t *testing.T) {
+	if _, err := Formatters.LookupSectionFormatter(conf.AsciidocFormat); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	_, err := Formatters.LookupSectionFormatter(conf.JsonFormat)
+	expected := "invalid format: json can't be injected"
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected: %s, but got: %v", expected, err)
+	}
+}
+
+func TestFormatters_Names(t *testing.T) {
+	if diff := cmp.Diff(Formatters.Names(), conf.AllFormats); diff != "" {
+		t.Errorf("the formats validated by the config file differ from the registry: %s", diff)
+	}
+}
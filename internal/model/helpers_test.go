@@ -0,0 +1,24 @@
+package model
+
+import (
+	"io"
+
+	"github.com/tmknom/actdocs/internal/conf"
+	"github.com/tmknom/actdocs/internal/util"
+)
+
+func NewNullValue() *util.NullString {
+	return util.NewNullString(nil)
+}
+
+func NewNotNullValue(value string) *util.NullString {
+	return util.NewNullString(&value)
+}
+
+// render injects the spec into every directive of the template.
+func render(template io.Reader, spec *Spec) (string, error) {
+	resolver := func(string) (util.Document, error) { return spec, nil }
+	return util.NewInjector(template, conf.AllSections, resolver).Inject()
+}
+
+const testBaseDir = "../../"
@@ -0,0 +1,184 @@
+package model
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/tmknom/actdocs/internal/conf"
+	"github.com/tmknom/actdocs/internal/util"
+)
+
+type HtmlFormatter struct{}
+
+func (f *HtmlFormatter) Format(spec *Spec) string {
+	if spec.Page {
+		return spec.ToHtmlPage()
+	}
+	return spec.ToHtml()
+}
+
+// ToHtml returns the HTML fragment, which can be embedded into other pages.
+func (s *Spec) ToHtml() string {
+	return s.joinSections(s.toSectionHtml)
+}
+
+// ToHtmlPage returns the self-contained HTML page titled with the name.
+func (s *Spec) ToHtmlPage() string {
+	title := s.Kind
+	if s.Name.IsValid() {
+		title = s.Name.Value
+	}
+
+	var sb strings.Builder
+	sb.WriteString("<!DOCTYPE html>\n")
+	sb.WriteString("<html lang=\"en\">\n")
+	sb.WriteString("<head>\n")
+	sb.WriteString("<meta charset=\"utf-8\">\n")
+	sb.WriteString(fmt.Sprintf("<title>%s</title>\n", html.EscapeString(title)))
+	sb.WriteString("</head>\n")
+	sb.WriteString("<body>\n")
+	sb.WriteString(fmt.Sprintf("<h1>%s</h1>\n", html.EscapeString(title)))
+	if content := s.ToHtml(); content != "" {
+		sb.WriteString(content)
+		sb.WriteString("\n")
+	}
+	sb.WriteString("</body>\n")
+	sb.WriteString("</html>")
+	return sb.String()
+}
+
+func (s *Spec) toSectionHtml(section string) string {
+	switch section {
+	case conf.DescriptionSection:
+		return s.toDescriptionHtml()
+	case conf.InputsSection:
+		return toTableHtml(s, section, InputFields, s.Inputs)
+	case conf.SecretsSection:
+		return toTableHtml(s, section, SecretFields, s.Secrets)
+	case conf.OutputsSection:
+		return toTableHtml(s, section, OutputFields, s.Outputs)
+	case conf.PermissionsSection:
+		return toTableHtml(s, section, PermissionFields, s.Permissions)
+	}
+	return ""
+}
+
+func (s *Spec) toDescriptionHtml() string {
+	if s.omitted(conf.DescriptionSection) && !s.Description.IsValid() {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString(s.headingHtml(conf.DescriptionSection))
+	sb.WriteString("\n")
+	sb.WriteString(paragraphsHtml(strings.TrimSpace(s.Description.StringOrUpperNA())))
+	return sb.String()
+}
+
+// toTableHtml returns the section having the table of the items, or "N/A" if the items are empty.
+// Each row has the anchor id such as "input-name", where the colliding ids are suffixed such as "input-name-2".
+func toTableHtml[T any](s *Spec, section string, fields []*Field[T], items []T) string {
+	if s.omitted(section) && len(items) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString(s.headingHtml(section))
+	sb.WriteString("\n")
+	if len(items) == 0 {
+		sb.WriteString(paragraphsHtml(util.UpperNAString))
+		return sb.String()
+	}
+
+	selected := selectFields(s, section, fields)
+	sb.WriteString("<table>\n")
+	sb.WriteString("<thead>\n")
+	sb.WriteString("<tr>")
+	for _, field := range selected {
+		sb.WriteString(fmt.Sprintf("<th scope=\"col\">%s</th>", html.EscapeString(field.Title)))
+	}
+	sb.WriteString("</tr>\n")
+	sb.WriteString("</thead>\n")
+	sb.WriteString("<tbody>\n")
+	seen := map[string]int{}
+	for _, item := range items {
+		// The first field is the identifier of the item such as the name.
+		id := htmlAnchor(htmlAnchorPrefixes[section], fields[0].Value(item).Value)
+		seen[id]++
+		for seen[id] > 1 {
+			suffixed := fmt.Sprintf("%s-%d", id, seen[id])
+			if seen[suffixed] == 0 {
+				seen[suffixed]++
+				id = suffixed
+				break
+			}
+			seen[id]++
+		}
+		sb.WriteString(fmt.Sprintf("<tr id=\"%s\">", id))
+		for _, field := range selected {
+			sb.WriteString(fmt.Sprintf("<td>%s</td>", htmlCell(field.Style, field.Value(item))))
+		}
+		sb.WriteString("</tr>\n")
+	}
+	sb.WriteString("</tbody>\n")
+	sb.WriteString("</table>")
+	return sb.String()
+}
+
+func (s *Spec) headingHtml(section string) string {
+	level := max(util.MinHeadingLevel, min(s.headingLevel(), util.MaxHeadingLevel))
+	return fmt.Sprintf("<h%d id=\"%s\">%s</h%d>", level, section, html.EscapeString(s.title(section)), level)
+}
+
+// htmlCell returns the escaped table cell of the value, where multi-line code is rendered as the code block.
+func htmlCell(style FieldStyle, value *util.NullString) string {
+	switch style {
+	case TextStyle:
+		return htmlLines(strings.TrimSpace(value.Value))
+	case CodeStyle:
+		if !value.IsValid() {
+			return util.LowerNAString
+		}
+		if code := strings.TrimRight(value.Value, "\n"); strings.Contains(code, "\n") {
+			return "<pre><code>" + html.EscapeString(code) + "</code></pre>"
+		}
+		return "<code>" + html.EscapeString(value.Value) + "</code>"
+	case FlagStyle:
+		return value.YesOrNo()
+	}
+	return html.EscapeString(value.Value)
+}
+
+// paragraphsHtml returns the paragraphs of the text separated by blank lines.
+func paragraphsHtml(text string) string {
+	//goland:noinspection GoPreferNilSlice
+	paragraphs := []string{}
+	for _, paragraph := range strings.Split(strings.ReplaceAll(text, "\r", ""), "\n\n") {
+		if paragraph = strings.TrimSpace(paragraph); paragraph != "" {
+			paragraphs = append(paragraphs, "<p>"+htmlLines(paragraph)+"</p>")
+		}
+	}
+	return strings.Join(paragraphs, "\n")
+}
+
+// htmlLines returns the escaped text, where the line breaks are kept as <br>.
+func htmlLines(text string) string {
+	lines := strings.Split(strings.ReplaceAll(text, "\r", ""), "\n")
+	for i, line := range lines {
+		lines[i] = html.EscapeString(line)
+	}
+	return strings.Join(lines, "<br>\n")
+}
+
+// htmlAnchor returns the anchor id such as "input-name", where whitespace is replaced with hyphens.
+func htmlAnchor(prefix string, name string) string {
+	return html.EscapeString(prefix + "-" + strings.Join(strings.Fields(name), "-"))
+}
+
+var htmlAnchorPrefixes = map[string]string{
+	conf.InputsSection:      "input",
+	conf.SecretsSection:     "secret",
+	conf.OutputsSection:     "output",
+	conf.PermissionsSection: "permission",
+}
@@ -0,0 +1,190 @@
+package model
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tmknom/actdocs/internal/conf"
+	"github.com/tmknom/actdocs/internal/util"
+)
+
+func TestSpec_ToHtml(t *testing.T) {
+	action := NewDocument(util.ActionKind)
+	action.Description = NewNotNullValue("This is a <test> & more.\r\n\r\nThe second\nparagraph.\n")
+	action.Inputs = []*Input{
+		{Name: "single", Default: NewNotNullValue("<b>"), Description: NewNotNullValue("The \"single\" line."), Required: NewNotNullValue("true")},
+		{Name: "multi", Default: NewNotNullValue("foo\n  bar\n"), Description: NewNotNullValue("The first line.\nThe second line."), Required: NewNullValue()},
+		{Name: "null", Default: NewNullValue(), Description: NewNullValue(), Required: NewNullValue()},
+	}
+
+	cases := []struct {
+		name     string
+		sut      *Spec
+		expected string
+	}{
+		{
+			name:     "escaped values",
+			sut:      NewSpec(action, &conf.FormatterConfig{Sections: []string{conf.DescriptionSection, conf.InputsSection}}),
+			expected: escapedActionExpectedHtml,
+		},
+		{
+			name:     "clamped heading",
+			sut:      NewSpec(NewDocument(util.ActionKind), &conf.FormatterConfig{Sections: []string{conf.OutputsSection}, HeadingOffset: 9, Titles: map[string]string{conf.OutputsSection: "<Results>"}}),
+			expected: "<h6 id=\"outputs\">&lt;Results&gt;</h6>\n<p>N/A</p>",
+		},
+	}
+
+	for _, tc := range cases {
+		got := tc.sut.ToHtml()
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("%s: diff: %s", tc.name, diff)
+		}
+	}
+}
+
+const escapedActionExpectedHtml = `<h2 id="description">Description</h2>
+<p>This is a &lt;test&gt; &amp; more.</p>
+<p>The second<br>
+paragraph.</p>
+
+<h2 id="inputs">Inputs</h2>
+<table>
+<thead>
+<tr><th scope="col">Name</th><th scope="col">Description</th><th scope="col">Default</th><th scope="col">Required</th></tr>
+</thead>
+<tbody>
+<tr id="input-single"><td>single</td><td>The &#34;single&#34; line.</td><td><code>&lt;b&gt;</code></td><td>yes</td></tr>
+<tr id="input-multi"><td>multi</td><td>The first line.<br>
+The second line.</td><td><pre><code>foo
+  bar</code></pre></td><td>no</td></tr>
+<tr id="input-null"><td>null</td><td></td><td>n/a</td><td>no</td></tr>
+</tbody>
+</table>`
+
+func TestSpec_ToHtmlWithAnchors(t *testing.T) {
+	cases := []struct {
+		name     string
+		outputs  []string
+		expected []string
+	}{
+		{
+			name:     "unique",
+			outputs:  []string{"first", "second"},
+			expected: []string{"output-first", "output-second"},
+		},
+		{
+			name:     "whitespace",
+			outputs:  []string{" with  space ", "with\ttab"},
+			expected: []string{"output-with-space", "output-with-tab"},
+		},
+		{
+			name:     "collisions",
+			outputs:  []string{"a b", "a-b", "a  b"},
+			expected: []string{"output-a-b", "output-a-b-2", "output-a-b-3"},
+		},
+		{
+			name:     "collisions with suffixed name",
+			outputs:  []string{"a-2", "a", "a"},
+			expected: []string{"output-a-2", "output-a", "output-a-3"},
+		},
+		{
+			name:     "escaped",
+			outputs:  []string{`"<x>"`},
+			expected: []string{"output-&#34;&lt;x&gt;&#34;"},
+		},
+	}
+
+	for _, tc := range cases {
+		document := NewDocument(util.WorkflowKind)
+		for _, name := range tc.outputs {
+			document.Outputs = append(document.Outputs, NewOutput(name))
+		}
+		sut := NewSpec(document, &conf.FormatterConfig{Sections: []string{conf.OutputsSection}})
+
+		//goland:noinspection GoPreferNilSlice
+		got := []string{}
+		for _, match := range htmlRowIdPattern.FindAllStringSubmatch(sut.ToHtml(), -1) {
+			got = append(got, match[1])
+		}
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("%s: diff: %s", tc.name, diff)
+		}
+	}
+}
+
+func TestSpec_ToHtmlPage(t *testing.T) {
+	named := NewDocument(util.ActionKind)
+	named.Name = NewNotNullValue("Test <Action> & more")
+
+	cases := []struct {
+		name     string
+		sut      *Spec
+		expected string
+	}{
+		{
+			name:     "name",
+			sut:      NewSpec(named, &conf.FormatterConfig{Sections: []string{conf.OutputsSection}, Page: true}),
+			expected: namedPageExpectedHtml,
+		},
+		{
+			name:     "without name",
+			sut:      NewSpec(NewDocument(util.WorkflowKind), &conf.FormatterConfig{Sections: []string{conf.OutputsSection}, Page: true}),
+			expected: unnamedPageExpectedHtml,
+		},
+		{
+			name:     "without content",
+			sut:      NewSpec(NewDocument(util.WorkflowKind), &conf.FormatterConfig{Omit: true, Page: true}),
+			expected: emptyPageExpectedHtml,
+		},
+	}
+
+	for _, tc := range cases {
+		got, err := tc.sut.Format(conf.HtmlFormat)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.name, err)
+		}
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("%s: diff: %s", tc.name, diff)
+		}
+	}
+}
+
+const namedPageExpectedHtml = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Test &lt;Action&gt; &amp; more</title>
+</head>
+<body>
+<h1>Test &lt;Action&gt; &amp; more</h1>
+<h2 id="outputs">Outputs</h2>
+<p>N/A</p>
+</body>
+</html>`
+
+const unnamedPageExpectedHtml = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>workflow</title>
+</head>
+<body>
+<h1>workflow</h1>
+<h2 id="outputs">Outputs</h2>
+<p>N/A</p>
+</body>
+</html>`
+
+const emptyPageExpectedHtml = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>workflow</title>
+</head>
+<body>
+<h1>workflow</h1>
+</body>
+</html>`
+
+var htmlRowIdPattern = regexp.MustCompile(`<tr id="([^"]*)">`)
@@ -0,0 +1,17 @@
+package model
+
+import "encoding/json"
+
+type JsonFormatter struct{}
+
+func (f *JsonFormatter) Format(spec *Spec) string {
+	return spec.ToJson()
+}
+
+func (s *Spec) ToJson() string {
+	bytes, err := json.MarshalIndent(s.schema(), "", "  ")
+	if err != nil {
+		return "{}"
+	}
+	return string(bytes)
+}
@@ -0,0 +1,236 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/tmknom/actdocs/internal/conf"
+	"github.com/tmknom/actdocs/internal/util"
+)
+
+type MarkdownFormatter struct{}
+
+func (f *MarkdownFormatter) Format(spec *Spec) string {
+	return spec.ToMarkdown()
+}
+
+func (f *MarkdownFormatter) FormatSection(spec *Spec, section string) string {
+	return spec.toSectionMarkdown(section)
+}
+
+func (s *Spec) ToMarkdown() string {
+	return s.joinSections(s.toSectionMarkdown)
+}
+
+func (s *Spec) toSectionMarkdown(section string) string {
+	switch section {
+	case conf.DescriptionSection:
+		return s.ToDescriptionMarkdown()
+	case conf.InputsSection:
+		return s.ToInputsMarkdown()
+	case conf.SecretsSection:
+		return s.ToSecretsMarkdown()
+	case conf.OutputsSection:
+		return s.ToOutputsMarkdown()
+	case conf.PermissionsSection:
+		return s.ToPermissionsMarkdown()
+	}
+	return ""
+}
+
+func (s *Spec) ToDescriptionMarkdown() string {
+	if s.omitted(conf.DescriptionSection) && !s.Description.IsValid() {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString(util.Heading(s.headingLevel(), s.title(conf.DescriptionSection)))
+	sb.WriteString("\n\n")
+	sb.WriteString(strings.TrimSpace(s.Description.StringOrUpperNA()))
+	return sb.String()
+}
+
+func (s *Spec) ToInputsMarkdown() string {
+	return toItemsMarkdown(s, conf.InputsSection, InputFields, InputsColumns, s.Inputs)
+}
+
+func (s *Spec) ToSecretsMarkdown() string {
+	return toItemsMarkdown(s, conf.SecretsSection, SecretFields, SecretsColumns, s.Secrets)
+}
+
+func (s *Spec) ToOutputsMarkdown() string {
+	return toItemsMarkdown(s, conf.OutputsSection, OutputFields, OutputsColumns, s.Outputs)
+}
+
+func (s *Spec) ToPermissionsMarkdown() string {
+	return toItemsMarkdown(s, conf.PermissionsSection, PermissionFields, PermissionsColumns, s.Permissions)
+}
+
+// toItemsMarkdown returns the section in the layout of the section.
+func toItemsMarkdown[T any](s *Spec, section string, fields []*Field[T], columns []*util.Column[T], items []T) string {
+	if s.layout(section) == conf.ListLayout {
+		return toListMarkdown(s, section, fields, items)
+	}
+	return toTableMarkdown(s, section, columns, items)
+}
+
+// toTableMarkdown returns the section having the table of the items, or "N/A" if the items are empty.
+func toTableMarkdown[T any](s *Spec, section string, columns []*util.Column[T], items []T) string {
+	if s.omitted(section) && len(items) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString(util.Heading(s.headingLevel(), s.title(section)))
+	sb.WriteString("\n\n")
+	if len(items) != 0 {
+		selected := selectColumns(s, section, columns)
+		sb.WriteString(util.TableHeader(selected))
+		sb.WriteString("\n")
+		sb.WriteString(util.TableColumnSeparator(selected))
+		sb.WriteString("\n")
+		for _, item := range items {
+			sb.WriteString(util.TableRow(selected, item))
+			sb.WriteString("\n")
+		}
+	} else {
+		sb.WriteString(util.UpperNAString)
+	}
+	return strings.TrimSpace(sb.String())
+}
+
+// toListMarkdown returns the section having the sub-heading of each item, or "N/A" if the items are empty.
+// Each item has the bullet list of the fields, and the description as free-form markdown.
+func toListMarkdown[T any](s *Spec, section string, fields []*Field[T], items []T) string {
+	if s.omitted(section) && len(items) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString(util.Heading(s.headingLevel(), s.title(section)))
+	sb.WriteString("\n\n")
+	if len(items) == 0 {
+		sb.WriteString(util.UpperNAString)
+		return sb.String()
+	}
+
+	selected := selectFields(s, section, fields)
+	for _, item := range items {
+		// The first field is the identifier of the item such as the name.
+		sb.WriteString(util.Heading(s.headingLevel()+1, fields[0].Value(item).Value))
+		sb.WriteString("\n\n")
+		attributes := false
+		for _, field := range selected {
+			if field == fields[0] || field.Key == conf.DescriptionColumn {
+				continue
+			}
+			sb.WriteString(listItemMarkdown(field.Title, field.Style, field.Value(item)))
+			sb.WriteString("\n")
+			attributes = true
+		}
+		if attributes {
+			sb.WriteString("\n")
+		}
+		for _, field := range selected {
+			if description := strings.TrimSpace(field.Value(item).Value); field.Key == conf.DescriptionColumn && description != "" {
+				sb.WriteString(description)
+				sb.WriteString("\n\n")
+			}
+		}
+	}
+	return strings.TrimSpace(sb.String())
+}
+
+// listItemMarkdown returns the bullet of the field, where multi-line values are indented under the bullet.
+func listItemMarkdown(title string, style FieldStyle, value *util.NullString) string {
+	if style == CodeStyle && strings.Contains(strings.TrimRight(value.Value, "\n"), "\n") {
+		code := strings.TrimRight(strings.ReplaceAll(value.Value, "\r", ""), "\n")
+		fence := util.MarkdownCodeFence(code)
+		block := fence + "\n" + code + "\n" + fence
+		return "- " + title + ":\n\n  " + indentMarkdown(block, "  ")
+	}
+
+	var cell string
+	switch style {
+	case TextStyle:
+		cell = strings.TrimSpace(value.Value)
+	case CodeStyle:
+		cell = util.LowerNAString
+		if value.IsValid() {
+			cell = util.MarkdownCodeSpan(value.Value)
+		}
+	case FlagStyle:
+		cell = value.YesOrNo()
+	default:
+		cell = value.Value
+	}
+	return strings.TrimRight("- "+title+": "+indentMarkdown(cell, "  "), " ")
+}
+
+// indentMarkdown indents the lines except the first line, keeping blank lines empty.
+func indentMarkdown(text string, indent string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if i != 0 && line != "" {
+			lines[i] = indent + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+var InputsColumns = markdownColumns(InputFields)
+var SecretsColumns = markdownColumns(SecretFields)
+var OutputsColumns = markdownColumns(OutputFields)
+var PermissionsColumns = markdownColumns(PermissionFields)
+
+// markdownColumns returns the markdown table columns of the fields.
+func markdownColumns[T any](fields []*Field[T]) []*util.Column[T] {
+	//goland:noinspection GoPreferNilSlice
+	columns := []*util.Column[T]{}
+	for _, field := range fields {
+		column := &util.Column[T]{
+			Key:       field.Key,
+			Title:     field.Title,
+			Separator: markdownSeparators[field.Key],
+			Value:     func(item T) string { return markdownCell(field.Style, field.Value(item)) },
+		}
+		if field.Style == FlagStyle {
+			column.Align = util.AlignCenter
+		}
+		columns = append(columns, column)
+	}
+	return columns
+}
+
+func markdownCell(style FieldStyle, value *util.NullString) string {
+	switch style {
+	case TextStyle:
+		return value.StringOrEmpty()
+	case CodeStyle:
+		return value.QuoteStringOrLowerNA()
+	case FlagStyle:
+		return value.YesOrNo()
+	}
+	return util.EscapeMarkdownCell(value.Value)
+}
+
+// markdownSeparators overrides the separators of the columns, kept for compatibility.
+var markdownSeparators = map[string]string{
+	conf.ScopeColumn:  ":---",
+	conf.AccessColumn: ":----",
+}
+
+var DefaultTitles = map[string]string{
+	conf.DescriptionSection: DescriptionTitle,
+	conf.InputsSection:      InputsTitle,
+	conf.SecretsSection:     SecretsTitle,
+	conf.OutputsSection:     OutputsTitle,
+	conf.PermissionsSection: PermissionsTitle,
+}
+
+const (
+	DescriptionTitle = "Description"
+	InputsTitle      = "Inputs"
+	SecretsTitle     = "Secrets"
+	OutputsTitle     = "Outputs"
+	PermissionsTitle = "Permissions"
+)
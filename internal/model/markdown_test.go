@@ -0,0 +1,161 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tmknom/actdocs/internal/conf"
+	"github.com/tmknom/actdocs/internal/util"
+)
+
+func TestSpec_ToMarkdownWithListLayout(t *testing.T) {
+	workflow := NewDocument(util.WorkflowKind)
+	workflow.Inputs = []*Input{
+		{Name: "single", Default: NewNotNullValue("foo"), Description: NewNotNullValue("The **single** line."), Required: NewNotNullValue("true"), Type: NewNotNullValue("string")},
+		{Name: "multi", Default: NewNotNullValue("foo\n```bar\n"), Description: NewNotNullValue("The first line.\n\n- item\n"), Required: NewNullValue(), Type: NewNullValue()},
+	}
+	workflow.Outputs = []*Output{{Name: "result", Description: NewNullValue()}}
+	workflow.Permissions = []*Permission{NewPermission("contents", "write")}
+
+	cases := []struct {
+		name     string
+		sut      *Spec
+		expected string
+	}{
+		{
+			name:     "global",
+			sut:      NewSpec(workflow, &conf.FormatterConfig{Layout: conf.ListLayout}),
+			expected: globalListLayoutExpected,
+		},
+		{
+			name:     "per section",
+			sut:      NewSpec(workflow, &conf.FormatterConfig{Sections: []string{conf.OutputsSection, conf.PermissionsSection}, Layouts: map[string]string{conf.PermissionsSection: conf.ListLayout}}),
+			expected: sectionListLayoutExpected,
+		},
+		{
+			name:     "columns",
+			sut:      NewSpec(workflow, &conf.FormatterConfig{Sections: []string{conf.InputsSection}, Layout: conf.ListLayout, HeadingOffset: 1, Columns: map[string][]string{conf.InputsSection: {conf.RequiredColumn}}}),
+			expected: columnsListLayoutExpected,
+		},
+		{
+			name:     "empty",
+			sut:      NewSpec(NewDocument(util.WorkflowKind), &conf.FormatterConfig{Sections: []string{conf.SecretsSection}, Layout: conf.ListLayout}),
+			expected: "## Secrets\n\nN/A",
+		},
+	}
+
+	for _, tc := range cases {
+		got := tc.sut.ToMarkdown()
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("%s: diff: %s", tc.name, diff)
+		}
+	}
+}
+
+const globalListLayoutExpected = `## Inputs
+
+### single
+
+- Type: ` + "`string`" + `
+- Default: ` + "`foo`" + `
+- Required: yes
+
+The **single** line.
+
+### multi
+
+- Type: n/a
+- Default:
+
+  ` + "````" + `
+  foo
+  ` + "```bar" + `
+  ` + "````" + `
+- Required: no
+
+The first line.
+
+- item
+
+## Secrets
+
+N/A
+
+## Outputs
+
+### result
+
+## Permissions
+
+### contents
+
+- Access: write`
+
+const sectionListLayoutExpected = `## Outputs
+
+| Name | Description |
+| :--- | :---------- |
+| result |  |
+
+## Permissions
+
+### contents
+
+- Access: write`
+
+const columnsListLayoutExpected = `### Inputs
+
+#### single
+
+- Required: yes
+
+#### multi
+
+- Required: no`
+
+func TestSpec_RenderDirectiveWithLayout(t *testing.T) {
+	document := NewDocument(util.ActionKind)
+	document.Outputs = []*Output{{Name: "result", Description: NewNotNullValue("The result.")}}
+	spec := NewSpec(document, conf.DefaultFormatterConfig())
+
+	template := "<!-- actdocs outputs start layout=list -->\n<!-- actdocs outputs end -->\n"
+	got, err := render(strings.NewReader(template), spec)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := "<!-- actdocs outputs start layout=list -->\n\n## Outputs\n\n### result\n\nThe result.\n\n<!-- actdocs outputs end -->\n"
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("diff: %s", diff)
+	}
+}
+
+func TestSpec_RenderDirectiveWithSortAttribute(t *testing.T) {
+	document := NewDocument(util.ActionKind)
+	document.Outputs = []*Output{NewOutput("second"), NewOutput("first")}
+	spec := NewSortedSpec(document, &conf.SortConfig{SortByName: true}, &conf.FormatterConfig{Sections: []string{conf.OutputsSection}, Columns: map[string][]string{conf.OutputsSection: {conf.NameColumn}}})
+
+	cases := []struct {
+		attribute string
+		expected  string
+	}{
+		{attribute: "", expected: "| first |\n| second |"},
+		{attribute: " sort=false", expected: "| second |\n| first |"},
+		{attribute: " sort=required", expected: "| second |\n| first |"},
+		{attribute: " sort=true", expected: "| first |\n| second |"},
+	}
+
+	for _, tc := range cases {
+		template := "<!-- actdocs outputs start" + tc.attribute + " -->\n<!-- actdocs outputs end -->\n"
+		got, err := render(strings.NewReader(template), spec)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.attribute, err)
+		}
+
+		expected := "<!-- actdocs outputs start" + tc.attribute + " -->\n\n## Outputs\n\n| Name |\n| :--- |\n" + tc.expected + "\n\n<!-- actdocs outputs end -->\n"
+		if diff := cmp.Diff(got, expected); diff != "" {
+			t.Errorf("%s: diff: %s", tc.attribute, diff)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/tmknom/actdocs/internal/conf"
+	"github.com/tmknom/actdocs/internal/util"
+)
+
+type RstFormatter struct{}
+
+func (f *RstFormatter) Format(spec *Spec) string {
+	return spec.ToRst()
+}
+
+func (f *RstFormatter) FormatSection(spec *Spec, section string) string {
+	return spec.toSectionRst(section)
+}
+
+func (s *Spec) ToRst() string {
+	return s.joinSections(s.toSectionRst)
+}
+
+func (s *Spec) toSectionRst(section string) string {
+	switch section {
+	case conf.DescriptionSection:
+		return s.toDescriptionRst()
+	case conf.InputsSection:
+		return toTableRst(s, section, InputFields, s.Inputs)
+	case conf.SecretsSection:
+		return toTableRst(s, section, SecretFields, s.Secrets)
+	case conf.OutputsSection:
+		return toTableRst(s, section, OutputFields, s.Outputs)
+	case conf.PermissionsSection:
+		return toTableRst(s, section, PermissionFields, s.Permissions)
+	}
+	return ""
+}
+
+func (s *Spec) toDescriptionRst() string {
+	if s.omitted(conf.DescriptionSection) && !s.Description.IsValid() {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString(util.RstSyntax.Heading(s.headingLevel(), s.title(conf.DescriptionSection)))
+	sb.WriteString("\n\n")
+	sb.WriteString(rstEscaper.Replace(strings.TrimSpace(strings.ReplaceAll(s.Description.StringOrUpperNA(), "\r", ""))))
+	return sb.String()
+}
+
+// toTableRst returns the section having the list-table of the items, or "N/A" if the items are empty.
+func toTableRst[T any](s *Spec, section string, fields []*Field[T], items []T) string {
+	if s.omitted(section) && len(items) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString(util.RstSyntax.Heading(s.headingLevel(), s.title(section)))
+	sb.WriteString("\n\n")
+	if len(items) == 0 {
+		sb.WriteString(util.UpperNAString)
+		return sb.String()
+	}
+
+	selected := selectFields(s, section, fields)
+	sb.WriteString(".. list-table::\n")
+	sb.WriteString("   :header-rows: 1\n")
+	sb.WriteString("\n")
+	for i, field := range selected {
+		sb.WriteString(rstListItem(i, field.Title))
+	}
+	for _, item := range items {
+		for i, field := range selected {
+			sb.WriteString(rstListItem(i, rstCell(field.Style, field.Value(item))))
+		}
+	}
+	return strings.TrimRight(sb.String(), "\n")
+}
+
+// rstListItem returns the cell of the list-table, where the first cell starts the row.
+// The following lines of the cell are indented to the cell.
+func rstListItem(column int, cell string) string {
+	marker := "     -"
+	if column == 0 {
+		marker = "   * -"
+	}
+
+	lines := strings.Split(cell, "\n")
+	for i, line := range lines {
+		if i != 0 && line != "" {
+			lines[i] = rstCellIndent + line
+		}
+	}
+	return strings.TrimRight(marker+" "+strings.Join(lines, "\n"), " ") + "\n"
+}
+
+// rstCell returns the escaped table cell of the value, where multi-line code is rendered as the literal block.
+func rstCell(style FieldStyle, value *util.NullString) string {
+	switch style {
+	case TextStyle:
+		return rstEscaper.Replace(strings.TrimSpace(strings.ReplaceAll(value.Value, "\r", "")))
+	case CodeStyle:
+		if !value.IsValid() {
+			return util.LowerNAString
+		}
+		if value.Value == "" {
+			return ""
+		}
+		code := strings.TrimRight(strings.ReplaceAll(value.Value, "\r", ""), "\n")
+		if strings.Contains(code, "\n") || strings.Contains(code, "``") || strings.TrimSpace(code) != code {
+			return "::\n\n" + rstLiteralIndent + strings.ReplaceAll(code, "\n", "\n"+rstLiteralIndent)
+		}
+		return "``" + code + "``"
+	case FlagStyle:
+		return value.YesOrNo()
+	}
+	return rstEscaper.Replace(value.Value)
+}
+
+// rstEscaper escapes the inline markup such as "name_", which is the reference otherwise.
+var rstEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "`", "\\`", "_", `\_`, "|", `\|`)
+
+const rstCellIndent = "       "
+const rstLiteralIndent = "  "
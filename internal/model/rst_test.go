@@ -0,0 +1,129 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tmknom/actdocs/internal/conf"
+	"github.com/tmknom/actdocs/internal/util"
+)
+
+func TestSpec_ToRst(t *testing.T) {
+	action := NewDocument(util.ActionKind)
+	action.Inputs = []*Input{
+		{Name: "single", Default: NewNotNullValue("foo"), Description: NewNotNullValue("The single line."), Required: NewNotNullValue("true")},
+		{Name: "multi", Default: NewNotNullValue("foo\nbar\n"), Description: NewNotNullValue("The first line.\nThe second line."), Required: NewNullValue()},
+		{Name: "backquote", Default: NewNotNullValue("a``b"), Description: NewNullValue(), Required: NewNotNullValue("false")},
+		{Name: "padded", Default: NewNotNullValue(" a "), Description: NewNullValue(), Required: NewNullValue()},
+		{Name: "empty", Default: NewNotNullValue(""), Description: NewNullValue(), Required: NewNullValue()},
+		{Name: "null", Default: NewNullValue(), Description: NewNullValue(), Required: NewNullValue()},
+	}
+
+	sut := NewSpec(action, &conf.FormatterConfig{Sections: []string{conf.InputsSection}})
+	if diff := cmp.Diff(sut.ToRst(), listTableExpectedRst); diff != "" {
+		t.Errorf("diff: %s", diff)
+	}
+}
+
+const listTableExpectedRst = `Inputs
+------
+
+.. list-table::
+   :header-rows: 1
+
+   * - Name
+     - Description
+     - Default
+     - Required
+   * - single
+     - The single line.
+     - ` + "``foo``" + `
+     - yes
+   * - multi
+     - The first line.
+       The second line.
+     - ::
+
+         foo
+         bar
+     - no
+   * - backquote
+     -
+     - ::
+
+         a` + "``" + `b
+     - no
+   * - padded
+     -
+     - ::
+
+          a
+     - no
+   * - empty
+     -
+     -
+     - no
+   * - null
+     -
+     - n/a
+     - no`
+
+func TestSpec_ToRstWithEscape(t *testing.T) {
+	text := "Use *bold*, `code`, name_ and a|b in C:\\path.\r\n"
+	escaped := "Use \\*bold\\*, \\`code\\`, name\\_ and a\\|b in C:\\\\path."
+
+	action := NewDocument(util.ActionKind)
+	action.Description = NewNotNullValue(text)
+	action.Outputs = []*Output{{Name: "name_", Description: NewNotNullValue(text)}}
+	sut := NewSpec(action, &conf.FormatterConfig{Sections: []string{conf.DescriptionSection, conf.OutputsSection}})
+
+	expected := "Description\n-----------\n\n" + escaped + "\n\n" +
+		"Outputs\n-------\n\n.. list-table::\n   :header-rows: 1\n\n   * - Name\n     - Description\n" +
+		"   * - name\\_\n     - " + escaped
+	if diff := cmp.Diff(sut.ToRst(), expected); diff != "" {
+		t.Errorf("diff: %s", diff)
+	}
+}
+
+func TestSpec_ToRstWithHeadingLevel(t *testing.T) {
+	cases := []struct {
+		offset   int
+		expected string
+	}{
+		{offset: -1, expected: "======="},
+		{offset: 0, expected: "-------"},
+		{offset: 1, expected: "~~~~~~~"},
+		{offset: 2, expected: "^^^^^^^"},
+		{offset: 3, expected: `"""""""`},
+		{offset: 4, expected: "'''''''"},
+		{offset: 9, expected: "'''''''"},
+	}
+
+	for _, tc := range cases {
+		sut := NewSpec(NewDocument(util.ActionKind), &conf.FormatterConfig{Sections: []string{conf.OutputsSection}, HeadingOffset: tc.offset})
+		expected := "Outputs\n" + tc.expected + "\n\nN/A"
+		if diff := cmp.Diff(sut.ToRst(), expected); diff != "" {
+			t.Errorf("offset %d: diff: %s", tc.offset, diff)
+		}
+	}
+}
+
+func TestSpec_RenderDirectiveWithRst(t *testing.T) {
+	document := NewDocument(util.ActionKind)
+	document.Outputs = []*Output{{Name: "result", Description: NewNotNullValue("The result.")}}
+	spec := NewSpec(document, conf.DefaultFormatterConfig())
+
+	template := "Output test\n===========\n\n.. actdocs outputs start title=Results\n.. actdocs outputs end\n"
+	got, err := util.NewSyntaxInjector(strings.NewReader(template), util.RstSyntax, conf.AllSections, func(string) (util.Document, error) {
+		return spec, nil
+	}).Inject()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := "Output test\n===========\n\n.. actdocs outputs start title=Results\n\nResults\n-------\n\n.. list-table::\n   :header-rows: 1\n\n   * - Name\n     - Description\n   * - result\n     - The result.\n\n.. actdocs outputs end\n"
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("diff: %s", diff)
+	}
+}
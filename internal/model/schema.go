@@ -0,0 +1,82 @@
+package model
+
+import "github.com/tmknom/actdocs/internal/util"
+
+// schema returns the document in the structure of the kind, shared by the JSON and YAML formatters.
+func (s *Spec) schema() any {
+	switch s.Kind {
+	case util.ActionKind:
+		return newActionSchema(s.Document)
+	case util.WorkflowKind:
+		return newWorkflowSchema(s.Document)
+	}
+	return map[string]any{}
+}
+
+type actionSchema struct {
+	Description *util.NullString     `json:"description" yaml:"description"`
+	Inputs      []*actionInputSchema `json:"inputs" yaml:"inputs"`
+	Outputs     []*Output            `json:"outputs" yaml:"outputs"`
+}
+
+type actionInputSchema struct {
+	Name        string           `json:"name" yaml:"name"`
+	Default     *util.NullString `json:"default" yaml:"default"`
+	Description *util.NullString `json:"description" yaml:"description"`
+	Required    *util.NullString `json:"required" yaml:"required"`
+}
+
+func newActionSchema(document *Document) *actionSchema {
+	//goland:noinspection GoPreferNilSlice
+	inputs := []*actionInputSchema{}
+	for _, input := range document.Inputs {
+		inputs = append(inputs, &actionInputSchema{
+			Name:        input.Name,
+			Default:     input.Default,
+			Description: input.Description,
+			Required:    input.Required,
+		})
+	}
+
+	return &actionSchema{
+		Description: document.Description,
+		Inputs:      inputs,
+		Outputs:     document.Outputs,
+	}
+}
+
+type workflowSchema struct {
+	Inputs      []*workflowInputSchema `json:"inputs" yaml:"inputs"`
+	Secrets     []*Secret              `json:"secrets" yaml:"secrets"`
+	Outputs     []*Output              `json:"outputs" yaml:"outputs"`
+	Permissions []*Permission          `json:"permissions" yaml:"permissions"`
+}
+
+type workflowInputSchema struct {
+	Name        string           `json:"name" yaml:"name"`
+	Default     *util.NullString `json:"default" yaml:"default"`
+	Description *util.NullString `json:"description" yaml:"description"`
+	Required    *util.NullString `json:"required" yaml:"required"`
+	Type        *util.NullString `json:"type" yaml:"type"`
+}
+
+func newWorkflowSchema(document *Document) *workflowSchema {
+	//goland:noinspection GoPreferNilSlice
+	inputs := []*workflowInputSchema{}
+	for _, input := range document.Inputs {
+		inputs = append(inputs, &workflowInputSchema{
+			Name:        input.Name,
+			Default:     input.Default,
+			Description: input.Description,
+			Required:    input.Required,
+			Type:        input.Type,
+		})
+	}
+
+	return &workflowSchema{
+		Inputs:      inputs,
+		Secrets:     document.Secrets,
+		Outputs:     document.Outputs,
+		Permissions: document.Permissions,
+	}
+}
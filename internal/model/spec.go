@@ -0,0 +1,223 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/tmknom/actdocs/internal/conf"
+	"github.com/tmknom/actdocs/internal/util"
+)
+
+// Spec is the document with the formatter config, rendered by the formatters.
+type Spec struct {
+	*Document
+
+	Omit          bool
+	Sections      []string
+	OmitSections  []string
+	HeadingOffset int
+	Titles        map[string]string
+	Columns       map[string][]string
+	Page          bool
+	Layout        string
+	Layouts       map[string]string
+
+	// declared is the document in the declaration order, which the sort attribute sorts from.
+	declared *Document
+}
+
+func NewSpec(document *Document, formatter *conf.FormatterConfig) *Spec {
+	spec := &Spec{Document: document}
+	spec.setFormatterConfig(formatter)
+	return spec
+}
+
+// NewSortedSpec returns the spec of the sorted document, keeping the document in the declaration order,
+// so that the sort attribute such as "sort=false" sorts from the declaration order.
+func NewSortedSpec(document *Document, sort *conf.SortConfig, formatter *conf.FormatterConfig) *Spec {
+	spec := NewSpec(document.Sort(sort), formatter)
+	spec.declared = document
+	return spec
+}
+
+// Profile is the sections and the columns supported by the kind.
+type Profile struct {
+	Sections       []string
+	Columns        map[string][]string
+	DefaultColumns map[string][]string
+}
+
+var ActionProfile = &Profile{
+	Sections: []string{conf.DescriptionSection, conf.InputsSection, conf.OutputsSection},
+	Columns: map[string][]string{
+		conf.InputsSection:  {conf.NameColumn, conf.DescriptionColumn, conf.DefaultColumn, conf.RequiredColumn, conf.DeprecatedColumn},
+		conf.OutputsSection: {conf.NameColumn, conf.DescriptionColumn},
+	},
+	DefaultColumns: map[string][]string{
+		conf.InputsSection:  {conf.NameColumn, conf.DescriptionColumn, conf.DefaultColumn, conf.RequiredColumn},
+		conf.OutputsSection: {conf.NameColumn, conf.DescriptionColumn},
+	},
+}
+
+var WorkflowProfile = &Profile{
+	Sections: []string{conf.InputsSection, conf.SecretsSection, conf.OutputsSection, conf.PermissionsSection},
+	Columns: map[string][]string{
+		conf.InputsSection:      {conf.NameColumn, conf.DescriptionColumn, conf.TypeColumn, conf.DefaultColumn, conf.RequiredColumn},
+		conf.SecretsSection:     {conf.NameColumn, conf.DescriptionColumn, conf.RequiredColumn},
+		conf.OutputsSection:     {conf.NameColumn, conf.DescriptionColumn},
+		conf.PermissionsSection: {conf.ScopeColumn, conf.AccessColumn},
+	},
+	DefaultColumns: map[string][]string{
+		conf.InputsSection:      {conf.NameColumn, conf.DescriptionColumn, conf.TypeColumn, conf.DefaultColumn, conf.RequiredColumn},
+		conf.SecretsSection:     {conf.NameColumn, conf.DescriptionColumn, conf.RequiredColumn},
+		conf.OutputsSection:     {conf.NameColumn, conf.DescriptionColumn},
+		conf.PermissionsSection: {conf.ScopeColumn, conf.AccessColumn},
+	},
+}
+
+var Profiles = map[string]*Profile{
+	util.ActionKind:   ActionProfile,
+	util.WorkflowKind: WorkflowProfile,
+}
+
+// profile returns the profile of the kind, or the empty profile if the kind is unknown.
+func (s *Spec) profile() *Profile {
+	if profile, ok := Profiles[s.Kind]; ok {
+		return profile
+	}
+	return &Profile{}
+}
+
+// sections returns the sections to render in order, ignoring sections not supported by the kind.
+func (s *Spec) sections() []string {
+	supported := s.profile().Sections
+	if len(s.Sections) == 0 {
+		return supported
+	}
+
+	//goland:noinspection GoPreferNilSlice
+	result := []string{}
+	for _, section := range s.Sections {
+		if conf.ContainsSection(supported, section) {
+			result = append(result, section)
+		}
+	}
+	return result
+}
+
+func (s *Spec) omitted(section string) bool {
+	return s.Omit || conf.ContainsSection(s.OmitSections, section)
+}
+
+func (s *Spec) layout(section string) string {
+	return s.formatterConfig().LayoutOf(section)
+}
+
+// columnKeys returns the configured column keys of the section, or the default keys if not configured.
+func (s *Spec) columnKeys(section string) []string {
+	if keys, ok := s.Columns[section]; ok && len(keys) != 0 {
+		return keys
+	}
+	return s.profile().DefaultColumns[section]
+}
+
+// selectColumns returns the columns of the column keys, ignoring columns not supported by the kind.
+func selectColumns[T any](s *Spec, section string, columns []*util.Column[T]) []*util.Column[T] {
+	supported := util.SelectColumns(columns, s.profile().Columns[section])
+	return util.SelectColumns(supported, s.columnKeys(section))
+}
+
+func (s *Spec) title(section string) string {
+	if override, ok := s.Titles[section]; ok {
+		return override
+	}
+	return DefaultTitles[section]
+}
+
+func (s *Spec) headingLevel() int {
+	return util.DefaultHeadingLevel + s.HeadingOffset
+}
+
+// joinSections returns the rendered sections separated by blank lines, skipping empty sections.
+func (s *Spec) joinSections(render func(section string) string) string {
+	var sb strings.Builder
+	for _, section := range s.sections() {
+		content := render(section)
+		if content != "" {
+			sb.WriteString(content)
+			sb.WriteString("\n\n")
+		}
+	}
+	return strings.TrimSpace(sb.String())
+}
+
+// Supports reports whether the section is supported, where empty section means all sections.
+func (s *Spec) Supports(section string) bool {
+	return section == "" || conf.ContainsSection(s.profile().Sections, section)
+}
+
+// RenderDirective returns the directive section in the format of the directive with the directive attributes applied.
+func (s *Spec) RenderDirective(directive *util.Directive) (string, error) {
+	formatter, err := Formatters.LookupSectionFormatter(directive.Format)
+	if err != nil {
+		return "", err
+	}
+
+	attributed, err := s.withAttributes(directive)
+	if err != nil {
+		return "", err
+	}
+
+	if directive.Section == "" {
+		return formatter.Format(attributed), nil
+	}
+	return formatter.FormatSection(attributed, directive.Section), nil
+}
+
+// withAttributes returns a copy of the spec with the directive attributes applied.
+func (s *Spec) withAttributes(directive *util.Directive) (*Spec, error) {
+	formatter, sortConfig, err := conf.ApplyAttributes(directive.Attributes, directive.Section, s.formatterConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	result := *s
+	result.setFormatterConfig(formatter)
+	if sortConfig != nil {
+		result.Document = s.declaredDocument().Sort(sortConfig)
+	}
+	return &result, nil
+}
+
+// declaredDocument returns the document in the declaration order, or the document if unknown.
+func (s *Spec) declaredDocument() *Document {
+	if s.declared == nil {
+		return s.Document
+	}
+	return s.declared
+}
+
+func (s *Spec) formatterConfig() *conf.FormatterConfig {
+	return &conf.FormatterConfig{
+		Omit:          s.Omit,
+		Sections:      s.Sections,
+		OmitSections:  s.OmitSections,
+		HeadingOffset: s.HeadingOffset,
+		Titles:        s.Titles,
+		Columns:       s.Columns,
+		Page:          s.Page,
+		Layout:        s.Layout,
+		Layouts:       s.Layouts,
+	}
+}
+
+func (s *Spec) setFormatterConfig(formatter *conf.FormatterConfig) {
+	s.Omit = formatter.Omit
+	s.Sections = formatter.Sections
+	s.OmitSections = formatter.OmitSections
+	s.HeadingOffset = formatter.HeadingOffset
+	s.Titles = formatter.Titles
+	s.Columns = formatter.Columns
+	s.Page = formatter.Page
+	s.Layout = formatter.Layout
+	s.Layouts = formatter.Layouts
+}
@@ -1,13 +1,15 @@
-package workflow
+package model
 
 import (
+	"os"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/tmknom/actdocs/internal/conf"
+	"github.com/tmknom/actdocs/internal/util"
 )
 
-func TestSpec_ToJson(t *testing.T) {
+func TestSpec_ToJsonWithWorkflow(t *testing.T) {
 	cases := []struct {
 		name     string
 		sut      *Spec
@@ -16,31 +18,37 @@ func TestSpec_ToJson(t *testing.T) {
 		{
 			name: "empty",
 			sut: &Spec{
-				Inputs:      []*InputSpec{},
-				Secrets:     []*SecretSpec{},
-				Outputs:     []*OutputSpec{},
-				Permissions: []*PermissionSpec{},
+				Document: &Document{
+					Kind:        util.WorkflowKind,
+					Inputs:      []*Input{},
+					Secrets:     []*Secret{},
+					Outputs:     []*Output{},
+					Permissions: []*Permission{},
+				},
 			},
 			expected: emptyWorkflowExpectedJson,
 		},
 		{
 			name: "full",
 			sut: &Spec{
-				Inputs: []*InputSpec{
-					{Name: "minimal", Default: NewNullValue(), Description: NewNullValue(), Required: NewNullValue(), Type: NewNullValue()},
-					{Name: "full", Default: NewNotNullValue("true"), Description: NewNotNullValue("The input value."), Required: NewNotNullValue("true"), Type: NewNotNullValue("boolean")},
-				},
-				Secrets: []*SecretSpec{
-					{Name: "minimal", Description: NewNullValue(), Required: NewNullValue()},
-					{Name: "full", Description: NewNotNullValue("The secret value."), Required: NewNotNullValue("true")},
-				},
-				Outputs: []*OutputSpec{
-					{Name: "minimal", Description: NewNullValue()},
-					{Name: "full", Description: NewNotNullValue("The output value.")},
-				},
-				Permissions: []*PermissionSpec{
-					{Scope: "contents", Access: "write"},
-					{Scope: "pull-requests", Access: "read"},
+				Document: &Document{
+					Kind: util.WorkflowKind,
+					Inputs: []*Input{
+						{Name: "minimal", Default: NewNullValue(), Description: NewNullValue(), Required: NewNullValue(), Type: NewNullValue()},
+						{Name: "full", Default: NewNotNullValue("true"), Description: NewNotNullValue("The input value."), Required: NewNotNullValue("true"), Type: NewNotNullValue("boolean")},
+					},
+					Secrets: []*Secret{
+						{Name: "minimal", Description: NewNullValue(), Required: NewNullValue()},
+						{Name: "full", Description: NewNotNullValue("The secret value."), Required: NewNotNullValue("true")},
+					},
+					Outputs: []*Output{
+						{Name: "minimal", Description: NewNullValue()},
+						{Name: "full", Description: NewNotNullValue("The output value.")},
+					},
+					Permissions: []*Permission{
+						{Scope: "contents", Access: "write"},
+						{Scope: "pull-requests", Access: "read"},
+					},
 				},
 			},
 			expected: fullWorkflowExpectedJson,
@@ -113,7 +121,7 @@ const fullWorkflowExpectedJson = `{
   ]
 }`
 
-func TestSpec_ToMarkdown(t *testing.T) {
+func TestSpec_ToMarkdownWithWorkflow(t *testing.T) {
 	cases := []struct {
 		name     string
 		config   *conf.FormatterConfig
@@ -124,11 +132,14 @@ func TestSpec_ToMarkdown(t *testing.T) {
 			name:   "omit",
 			config: &conf.FormatterConfig{Format: conf.DefaultFormat, Omit: true},
 			markdown: &Spec{
-				Inputs:      []*InputSpec{},
-				Secrets:     []*SecretSpec{},
-				Outputs:     []*OutputSpec{},
-				Permissions: []*PermissionSpec{},
-				Omit:        true,
+				Document: &Document{
+					Kind:        util.WorkflowKind,
+					Inputs:      []*Input{},
+					Secrets:     []*Secret{},
+					Outputs:     []*Output{},
+					Permissions: []*Permission{},
+				},
+				Omit: true,
 			},
 			expected: "",
 		},
@@ -136,11 +147,14 @@ func TestSpec_ToMarkdown(t *testing.T) {
 			name:   "empty",
 			config: conf.DefaultFormatterConfig(),
 			markdown: &Spec{
-				Inputs:      []*InputSpec{},
-				Secrets:     []*SecretSpec{},
-				Outputs:     []*OutputSpec{},
-				Permissions: []*PermissionSpec{},
-				Omit:        false,
+				Document: &Document{
+					Kind:        util.WorkflowKind,
+					Inputs:      []*Input{},
+					Secrets:     []*Secret{},
+					Outputs:     []*Output{},
+					Permissions: []*Permission{},
+				},
+				Omit: false,
 			},
 			expected: emptyWorkflowExpected,
 		},
@@ -148,22 +162,43 @@ func TestSpec_ToMarkdown(t *testing.T) {
 			name:   "full",
 			config: conf.DefaultFormatterConfig(),
 			markdown: &Spec{
-				Inputs: []*InputSpec{
-					{Name: "single", Default: NewNotNullValue("5"), Description: NewNotNullValue("The number."), Required: NewNotNullValue("true"), Type: NewNotNullValue("number")},
-				},
-				Secrets: []*SecretSpec{
-					{Name: "single", Description: NewNotNullValue("The test description."), Required: NewNotNullValue("true")},
-				},
-				Outputs: []*OutputSpec{
-					{Name: "single", Description: NewNotNullValue("The test description.")},
-				},
-				Permissions: []*PermissionSpec{
-					{Scope: "contents", Access: "write"},
+				Document: &Document{
+					Kind: util.WorkflowKind,
+					Inputs: []*Input{
+						{Name: "single", Default: NewNotNullValue("5"), Description: NewNotNullValue("The number."), Required: NewNotNullValue("true"), Type: NewNotNullValue("number")},
+					},
+					Secrets: []*Secret{
+						{Name: "single", Description: NewNotNullValue("The test description."), Required: NewNotNullValue("true")},
+					},
+					Outputs: []*Output{
+						{Name: "single", Description: NewNotNullValue("The test description.")},
+					},
+					Permissions: []*Permission{
+						{Scope: "contents", Access: "write"},
+					},
 				},
 				Omit: false,
 			},
 			expected: fullWorkflowExpected,
 		},
+		{
+			name:   "sections and omit sections",
+			config: conf.DefaultFormatterConfig(),
+			markdown: &Spec{
+				Document: &Document{
+					Kind:    util.WorkflowKind,
+					Inputs:  []*Input{},
+					Secrets: []*Secret{},
+					Outputs: []*Output{},
+					Permissions: []*Permission{
+						{Scope: "contents", Access: "write"},
+					},
+				},
+				Sections:     []string{"description", "permissions", "secrets", "inputs"},
+				OmitSections: []string{"secrets"},
+			},
+			expected: sectionsWorkflowExpected,
+		},
 	}
 
 	for _, tc := range cases {
@@ -214,22 +249,32 @@ const fullWorkflowExpected = `## Inputs
 | :--- | :---- |
 | contents | write |`
 
-func TestSpec_ToInputsMarkdown(t *testing.T) {
+const sectionsWorkflowExpected = `## Permissions
+
+| Scope | Access |
+| :--- | :---- |
+| contents | write |
+
+## Inputs
+
+N/A`
+
+func TestSpec_ToInputsMarkdownWithWorkflow(t *testing.T) {
 	cases := []struct {
 		name     string
-		inputs   []*InputSpec
+		inputs   []*Input
 		omit     bool
 		expected string
 	}{
 		{
 			name:     "empty",
-			inputs:   []*InputSpec{},
+			inputs:   []*Input{},
 			omit:     false,
 			expected: "## Inputs\n\nN/A",
 		},
 		{
 			name: "minimal",
-			inputs: []*InputSpec{
+			inputs: []*Input{
 				{Name: "minimal", Default: NewNullValue(), Description: NewNullValue(), Required: NewNullValue(), Type: NewNullValue()},
 			},
 			omit:     false,
@@ -237,7 +282,7 @@ func TestSpec_ToInputsMarkdown(t *testing.T) {
 		},
 		{
 			name: "single",
-			inputs: []*InputSpec{
+			inputs: []*Input{
 				{Name: "single", Default: NewNotNullValue("5"), Description: NewNotNullValue("The number."), Required: NewNotNullValue("true"), Type: NewNotNullValue("number")},
 			},
 			omit:     false,
@@ -245,7 +290,7 @@ func TestSpec_ToInputsMarkdown(t *testing.T) {
 		},
 		{
 			name: "multiple",
-			inputs: []*InputSpec{
+			inputs: []*Input{
 				{Name: "multiple-1", Default: NewNotNullValue("The string"), Description: NewNotNullValue("1"), Required: NewNotNullValue("false"), Type: NewNotNullValue("string")},
 				{Name: "multiple-2", Default: NewNotNullValue("true"), Description: NewNotNullValue("2"), Required: NewNotNullValue("true"), Type: NewNotNullValue("boolean")},
 			},
@@ -255,7 +300,7 @@ func TestSpec_ToInputsMarkdown(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		spec := &Spec{Inputs: tc.inputs, Omit: tc.omit}
+		spec := &Spec{Document: &Document{Kind: util.WorkflowKind, Inputs: tc.inputs}, Omit: tc.omit}
 		got := spec.ToInputsMarkdown()
 
 		if diff := cmp.Diff(got, tc.expected); diff != "" {
@@ -264,22 +309,22 @@ func TestSpec_ToInputsMarkdown(t *testing.T) {
 	}
 }
 
-func TestSpec_ToSecretsMarkdown(t *testing.T) {
+func TestSpec_ToSecretsMarkdownWithWorkflow(t *testing.T) {
 	cases := []struct {
 		name     string
-		secrets  []*SecretSpec
+		secrets  []*Secret
 		omit     bool
 		expected string
 	}{
 		{
 			name:     "empty",
-			secrets:  []*SecretSpec{},
+			secrets:  []*Secret{},
 			omit:     false,
 			expected: "## Secrets\n\nN/A",
 		},
 		{
 			name: "minimal",
-			secrets: []*SecretSpec{
+			secrets: []*Secret{
 				{Name: "minimal", Description: NewNullValue(), Required: NewNullValue()},
 			},
 			omit:     false,
@@ -287,7 +332,7 @@ func TestSpec_ToSecretsMarkdown(t *testing.T) {
 		},
 		{
 			name: "single",
-			secrets: []*SecretSpec{
+			secrets: []*Secret{
 				{Name: "single", Description: NewNotNullValue("The test description."), Required: NewNotNullValue("true")},
 			},
 			omit:     false,
@@ -295,7 +340,7 @@ func TestSpec_ToSecretsMarkdown(t *testing.T) {
 		},
 		{
 			name: "multiple",
-			secrets: []*SecretSpec{
+			secrets: []*Secret{
 				{Name: "multiple-1", Description: NewNotNullValue("1"), Required: NewNotNullValue("false")},
 				{Name: "multiple-2", Description: NewNotNullValue("2"), Required: NewNotNullValue("true")},
 			},
@@ -305,7 +350,7 @@ func TestSpec_ToSecretsMarkdown(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		spec := &Spec{Secrets: tc.secrets, Omit: tc.omit}
+		spec := &Spec{Document: &Document{Kind: util.WorkflowKind, Secrets: tc.secrets}, Omit: tc.omit}
 		got := spec.ToSecretsMarkdown()
 
 		if diff := cmp.Diff(got, tc.expected); diff != "" {
@@ -314,22 +359,22 @@ func TestSpec_ToSecretsMarkdown(t *testing.T) {
 	}
 }
 
-func TestSpec_ToOutputsMarkdown(t *testing.T) {
+func TestSpec_ToOutputsMarkdownWithWorkflow(t *testing.T) {
 	cases := []struct {
 		name     string
-		outputs  []*OutputSpec
+		outputs  []*Output
 		omit     bool
 		expected string
 	}{
 		{
 			name:     "empty",
-			outputs:  []*OutputSpec{},
+			outputs:  []*Output{},
 			omit:     false,
 			expected: "## Outputs\n\nN/A",
 		},
 		{
 			name: "minimal",
-			outputs: []*OutputSpec{
+			outputs: []*Output{
 				{Name: "minimal", Description: NewNullValue()},
 			},
 			omit:     false,
@@ -337,7 +382,7 @@ func TestSpec_ToOutputsMarkdown(t *testing.T) {
 		},
 		{
 			name: "single",
-			outputs: []*OutputSpec{
+			outputs: []*Output{
 				{Name: "single", Description: NewNotNullValue("The test description.")},
 			},
 			omit:     false,
@@ -345,7 +390,7 @@ func TestSpec_ToOutputsMarkdown(t *testing.T) {
 		},
 		{
 			name: "multiple",
-			outputs: []*OutputSpec{
+			outputs: []*Output{
 				{Name: "multiple-1", Description: NewNotNullValue("1")},
 				{Name: "multiple-2", Description: NewNotNullValue("2")},
 			},
@@ -355,7 +400,7 @@ func TestSpec_ToOutputsMarkdown(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		spec := &Spec{Outputs: tc.outputs, Omit: tc.omit}
+		spec := &Spec{Document: &Document{Kind: util.WorkflowKind, Outputs: tc.outputs}, Omit: tc.omit}
 		got := spec.ToOutputsMarkdown()
 
 		if diff := cmp.Diff(got, tc.expected); diff != "" {
@@ -364,22 +409,22 @@ func TestSpec_ToOutputsMarkdown(t *testing.T) {
 	}
 }
 
-func TestSpec_ToPermissionsMarkdown(t *testing.T) {
+func TestSpec_ToPermissionsMarkdownWithWorkflow(t *testing.T) {
 	cases := []struct {
 		name        string
-		permissions []*PermissionSpec
+		permissions []*Permission
 		omit        bool
 		expected    string
 	}{
 		{
 			name:        "empty",
-			permissions: []*PermissionSpec{},
+			permissions: []*Permission{},
 			omit:        false,
 			expected:    "## Permissions\n\nN/A",
 		},
 		{
 			name: "single",
-			permissions: []*PermissionSpec{
+			permissions: []*Permission{
 				{Scope: "contents", Access: "write"},
 			},
 			omit:     false,
@@ -387,7 +432,7 @@ func TestSpec_ToPermissionsMarkdown(t *testing.T) {
 		},
 		{
 			name: "multiple",
-			permissions: []*PermissionSpec{
+			permissions: []*Permission{
 				{Scope: "contents", Access: "write"},
 				{Scope: "pull-requests", Access: "read"},
 			},
@@ -397,7 +442,7 @@ func TestSpec_ToPermissionsMarkdown(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		spec := &Spec{Permissions: tc.permissions, Omit: tc.omit}
+		spec := &Spec{Document: &Document{Kind: util.WorkflowKind, Permissions: tc.permissions}, Omit: tc.omit}
 		got := spec.ToPermissionsMarkdown()
 
 		if diff := cmp.Diff(got, tc.expected); diff != "" {
@@ -406,15 +451,15 @@ func TestSpec_ToPermissionsMarkdown(t *testing.T) {
 	}
 }
 
-func TestInputSpec_toMarkdown(t *testing.T) {
+func TestInputsColumnsWithWorkflow(t *testing.T) {
 	cases := []struct {
 		name     string
-		sut      *InputSpec
+		sut      *Input
 		expected string
 	}{
 		{
 			name: "single line",
-			sut: &InputSpec{
+			sut: &Input{
 				Name:        "single-line",
 				Default:     NewNotNullValue("Default value"),
 				Description: NewNotNullValue("The test description."),
@@ -425,7 +470,7 @@ func TestInputSpec_toMarkdown(t *testing.T) {
 		},
 		{
 			name: "multi line",
-			sut: &InputSpec{
+			sut: &Input{
 				Name:        "multi-line",
 				Default:     NewNotNullValue("{\n  \"key\": \"value\"\n}"),
 				Description: NewNotNullValue("one\ntwo\nthree"),
@@ -437,7 +482,7 @@ func TestInputSpec_toMarkdown(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		got := tc.sut.toMarkdown()
+		got := util.TableRow(util.SelectColumns(InputsColumns, WorkflowProfile.DefaultColumns[conf.InputsSection]), tc.sut)
 
 		if diff := cmp.Diff(got, tc.expected); diff != "" {
 			t.Errorf("diff: %s", diff)
@@ -445,15 +490,15 @@ func TestInputSpec_toMarkdown(t *testing.T) {
 	}
 }
 
-func TestSecretSpec_toMarkdown(t *testing.T) {
+func TestSecretsColumnsWithWorkflow(t *testing.T) {
 	cases := []struct {
 		name     string
-		sut      *SecretSpec
+		sut      *Secret
 		expected string
 	}{
 		{
 			name: "single line",
-			sut: &SecretSpec{
+			sut: &Secret{
 				Name:        "single-line",
 				Description: NewNotNullValue("The test description."),
 				Required:    NewNotNullValue("false"),
@@ -462,7 +507,7 @@ func TestSecretSpec_toMarkdown(t *testing.T) {
 		},
 		{
 			name: "multi line",
-			sut: &SecretSpec{
+			sut: &Secret{
 				Name:        "multi-line",
 				Description: NewNotNullValue("one\ntwo\nthree"),
 				Required:    NewNotNullValue("true"),
@@ -472,7 +517,7 @@ func TestSecretSpec_toMarkdown(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		got := tc.sut.toMarkdown()
+		got := util.TableRow(util.SelectColumns(SecretsColumns, WorkflowProfile.DefaultColumns[conf.SecretsSection]), tc.sut)
 
 		if diff := cmp.Diff(got, tc.expected); diff != "" {
 			t.Errorf("diff: %s", diff)
@@ -480,15 +525,15 @@ func TestSecretSpec_toMarkdown(t *testing.T) {
 	}
 }
 
-func TestOutputSpec_toMarkdown(t *testing.T) {
+func TestOutputsColumnsWithWorkflow(t *testing.T) {
 	cases := []struct {
 		name     string
-		sut      *OutputSpec
+		sut      *Output
 		expected string
 	}{
 		{
 			name: "single line",
-			sut: &OutputSpec{
+			sut: &Output{
 				Name:        "single-line",
 				Description: NewNotNullValue("The test description."),
 			},
@@ -496,7 +541,7 @@ func TestOutputSpec_toMarkdown(t *testing.T) {
 		},
 		{
 			name: "multi line",
-			sut: &OutputSpec{
+			sut: &Output{
 				Name:        "multi-line",
 				Description: NewNotNullValue("one\ntwo\nthree"),
 			},
@@ -505,7 +550,7 @@ func TestOutputSpec_toMarkdown(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		got := tc.sut.toMarkdown()
+		got := util.TableRow(util.SelectColumns(OutputsColumns, WorkflowProfile.DefaultColumns[conf.OutputsSection]), tc.sut)
 
 		if diff := cmp.Diff(got, tc.expected); diff != "" {
 			t.Errorf("diff: %s", diff)
@@ -513,24 +558,230 @@ func TestOutputSpec_toMarkdown(t *testing.T) {
 	}
 }
 
-func TestPermissionSpec_toMarkdown(t *testing.T) {
+func TestPermissionsColumnsWithWorkflow(t *testing.T) {
 	cases := []struct {
 		name     string
-		sut      *PermissionSpec
+		sut      *Permission
 		expected string
 	}{
 		{
 			name:     "valid",
-			sut:      &PermissionSpec{Scope: "contents", Access: "write"},
+			sut:      &Permission{Scope: "contents", Access: "write"},
 			expected: "| contents | write |",
 		},
 	}
 
 	for _, tc := range cases {
-		got := tc.sut.toMarkdown()
+		got := util.TableRow(util.SelectColumns(PermissionsColumns, WorkflowProfile.DefaultColumns[conf.PermissionsSection]), tc.sut)
 
 		if diff := cmp.Diff(got, tc.expected); diff != "" {
 			t.Errorf("diff: %s", diff)
 		}
 	}
 }
+
+func TestSpec_RenderDirectiveWithWorkflow(t *testing.T) {
+	cases := []struct {
+		name     string
+		spec     *Spec
+		template string
+		expected string
+	}{
+		{
+			name: "all",
+			spec: &Spec{
+				Document: &Document{
+					Kind: util.WorkflowKind,
+					Inputs: []*Input{
+						{Name: "full-number", Default: NewNotNullValue("5"), Description: NewNotNullValue("The full number value."), Required: NewNotNullValue("false"), Type: NewNotNullValue("number")},
+					},
+					Secrets: []*Secret{
+						{Name: "full", Description: NewNotNullValue("The secret value."), Required: NewNotNullValue("true")},
+					},
+					Outputs: []*Output{
+						{Name: "full", Description: NewNotNullValue("The output value.")},
+					},
+					Permissions: []*Permission{
+						{Scope: "contents", Access: "write"},
+					},
+				},
+			},
+			template: testBaseDir + "testdata/output.md",
+			expected: fullWorkflowRenderExpected,
+		},
+		{
+			name: "sections",
+			spec: &Spec{
+				Document: &Document{
+					Kind: util.WorkflowKind,
+					Inputs: []*Input{
+						{Name: "full-number", Default: NewNotNullValue("5"), Description: NewNotNullValue("The full number value."), Required: NewNotNullValue("false"), Type: NewNotNullValue("number")},
+					},
+					Secrets: []*Secret{
+						{Name: "full", Description: NewNotNullValue("The secret value."), Required: NewNotNullValue("true")},
+					},
+					Outputs: []*Output{
+						{Name: "full", Description: NewNotNullValue("The output value.")},
+					},
+					Permissions: []*Permission{
+						{Scope: "contents", Access: "write"},
+					},
+				},
+			},
+			template: testBaseDir + "testdata/inject-workflow-sections.md",
+			expected: sectionsWorkflowRenderExpected,
+		},
+		{
+			name: "attributes",
+			spec: &Spec{
+				Document: &Document{
+					Kind: util.WorkflowKind,
+					Inputs: []*Input{
+						{Name: "full-number", Default: NewNotNullValue("5"), Description: NewNotNullValue("The full number value."), Required: NewNotNullValue("false"), Type: NewNotNullValue("number")},
+					},
+					Secrets: []*Secret{
+						{Name: "second", Description: NewNotNullValue("The second value."), Required: NewNotNullValue("true")},
+						{Name: "first", Description: NewNotNullValue("The first value."), Required: NewNotNullValue("false")},
+					},
+					Outputs:     []*Output{},
+					Permissions: []*Permission{},
+				},
+			},
+			template: testBaseDir + "testdata/inject-workflow-attributes.md",
+			expected: attributesWorkflowRenderExpected,
+		},
+	}
+
+	for _, tc := range cases {
+		template, err := os.Open(tc.template)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		defer func(file *os.File) { err = file.Close() }(template)
+
+		got, err := render(template, tc.spec)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.name, err)
+		}
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("%s: diff: %s", tc.name, diff)
+		}
+	}
+}
+
+const fullWorkflowRenderExpected = `# Output test
+
+## Header
+
+This is a header.
+
+<!-- actdocs start -->
+
+## Inputs
+
+| Name | Description | Type | Default | Required |
+| :--- | :---------- | :--- | :------ | :------: |
+| full-number | The full number value. | ` + "`number`" + ` | ` + "`5`" + ` | no |
+
+## Secrets
+
+| Name | Description | Required |
+| :--- | :---------- | :------: |
+| full | The secret value. | yes |
+
+## Outputs
+
+| Name | Description |
+| :--- | :---------- |
+| full | The output value. |
+
+## Permissions
+
+| Scope | Access |
+| :--- | :---- |
+| contents | write |
+
+<!-- actdocs end -->
+
+## Footer
+
+This is a footer.
+`
+
+const sectionsWorkflowRenderExpected = `# Output test
+
+## Header
+
+This is a header.
+
+<!-- actdocs inputs start -->
+
+## Inputs
+
+| Name | Description | Type | Default | Required |
+| :--- | :---------- | :--- | :------ | :------: |
+| full-number | The full number value. | ` + "`number`" + ` | ` + "`5`" + ` | no |
+
+<!-- actdocs inputs end -->
+
+<!-- actdocs secrets start -->
+
+## Secrets
+
+| Name | Description | Required |
+| :--- | :---------- | :------: |
+| full | The secret value. | yes |
+
+<!-- actdocs secrets end -->
+
+<!-- actdocs outputs start -->
+
+## Outputs
+
+| Name | Description |
+| :--- | :---------- |
+| full | The output value. |
+
+<!-- actdocs outputs end -->
+
+<!-- actdocs permissions start -->
+
+## Permissions
+
+| Scope | Access |
+| :--- | :---- |
+| contents | write |
+
+<!-- actdocs permissions end -->
+
+## Footer
+
+This is a footer.
+`
+
+const attributesWorkflowRenderExpected = `# Output test
+
+<!-- actdocs secrets start sort=name heading=3 -->
+
+### Secrets
+
+| Name | Description | Required |
+| :--- | :---------- | :------: |
+| first | The first value. | no |
+| second | The second value. | yes |
+
+<!-- actdocs secrets end -->
+
+<!-- actdocs outputs start omit=true -->
+<!-- actdocs outputs end -->
+
+<!-- actdocs inputs start columns=name,required -->
+
+## Inputs
+
+| Name | Required |
+| :--- | :------: |
+| full-number | no |
+
+<!-- actdocs inputs end -->
+`
@@ -0,0 +1,21 @@
+package model
+
+import (
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+type YamlFormatter struct{}
+
+func (f *YamlFormatter) Format(spec *Spec) string {
+	return spec.ToYaml()
+}
+
+func (s *Spec) ToYaml() string {
+	bytes, err := yaml.Marshal(s.schema())
+	if err != nil {
+		return "{}"
+	}
+	return strings.TrimSuffix(string(bytes), "\n")
+}
@@ -0,0 +1,99 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tmknom/actdocs/internal/util"
+)
+
+func TestSpec_ToYaml(t *testing.T) {
+	cases := []struct {
+		name     string
+		sut      *Spec
+		expected string
+	}{
+		{
+			name:     "empty action",
+			sut:      &Spec{Document: NewDocument(util.ActionKind)},
+			expected: "description: null\ninputs: []\noutputs: []",
+		},
+		{
+			name: "full action",
+			sut: &Spec{
+				Document: &Document{
+					Kind:        util.ActionKind,
+					Description: NewNotNullValue("This is a test Custom Action for actdocs."),
+					Inputs: []*Input{
+						{Name: "minimal", Default: NewNullValue(), Description: NewNullValue(), Required: NewNullValue()},
+						{Name: "full", Default: NewNotNullValue("foo\nbar"), Description: NewNotNullValue("The input value."), Required: NewNotNullValue("true")},
+					},
+					Outputs: []*Output{
+						{Name: "full", Description: NewNotNullValue("The output value.")},
+					},
+				},
+			},
+			expected: fullActionExpectedYaml,
+		},
+		{
+			name: "full workflow",
+			sut: &Spec{
+				Document: &Document{
+					Kind: util.WorkflowKind,
+					Inputs: []*Input{
+						{Name: "full", Default: NewNotNullValue("5"), Description: NewNotNullValue("The input value."), Required: NewNotNullValue("false"), Type: NewNotNullValue("number")},
+					},
+					Secrets: []*Secret{
+						{Name: "full", Description: NewNullValue(), Required: NewNotNullValue("true")},
+					},
+					Outputs:     []*Output{},
+					Permissions: []*Permission{NewPermission("contents", "write")},
+				},
+			},
+			expected: fullWorkflowExpectedYaml,
+		},
+		{
+			name:     "unknown",
+			sut:      &Spec{Document: NewDocument("unknown")},
+			expected: "{}",
+		},
+	}
+
+	for _, tc := range cases {
+		got := tc.sut.ToYaml()
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("%s: diff: %s", tc.name, diff)
+		}
+	}
+}
+
+const fullActionExpectedYaml = `description: This is a test Custom Action for actdocs.
+inputs:
+- name: minimal
+  default: null
+  description: null
+  required: null
+- name: full
+  default: |-
+    foo
+    bar
+  description: The input value.
+  required: "true"
+outputs:
+- name: full
+  description: The output value.`
+
+const fullWorkflowExpectedYaml = `inputs:
+- name: full
+  default: "5"
+  description: The input value.
+  required: "false"
+  type: number
+secrets:
+- name: full
+  description: null
+  required: "true"
+outputs: []
+permissions:
+- scope: contents
+  access: write`
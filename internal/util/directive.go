@@ -0,0 +1,90 @@
+package util
+
+import (
+	"strings"
+)
+
+// Directive is an injection marker such as "<!-- actdocs inputs start heading-offset=1 -->".
+type Directive struct {
+	Section    string // empty for the all-in-one directive
+	Start      bool
+	Attributes map[string]string
+	// Format is the output format of the syntax the directive is written in, such as "markdown".
+	Format string
+}
+
+// ParseDirective parses the comment of the syntax as a directive, and returns false if the text isn't a directive.
+func (s *Syntax) ParseDirective(text string) (*Directive, bool) {
+	if !strings.HasPrefix(text, s.CommentPrefix) || !strings.HasSuffix(text, s.CommentSuffix) {
+		return nil, false
+	}
+
+	body := strings.TrimSuffix(strings.TrimPrefix(text, s.CommentPrefix), s.CommentSuffix)
+	tokens, ok := tokenize(body)
+	if !ok || len(tokens) == 0 || tokens[0] != directiveName {
+		return nil, false
+	}
+
+	directive := &Directive{Attributes: map[string]string{}, Format: s.Format}
+	tokens = tokens[1:]
+	if len(tokens) > 0 && tokens[0] != startKeyword && tokens[0] != endKeyword {
+		directive.Section = tokens[0]
+		tokens = tokens[1:]
+	}
+
+	if len(tokens) == 0 {
+		return nil, false
+	}
+	switch tokens[0] {
+	case startKeyword:
+		directive.Start = true
+	case endKeyword:
+		directive.Start = false
+	default:
+		return nil, false
+	}
+
+	for _, token := range tokens[1:] {
+		key, value, found := strings.Cut(token, "=")
+		if !found || key == "" || !directive.Start {
+			return nil, false
+		}
+		directive.Attributes[key] = value
+	}
+	return directive, true
+}
+
+// tokenize splits the text by whitespaces, where double-quoted values may contain whitespaces.
+func tokenize(text string) ([]string, bool) {
+	//goland:noinspection GoPreferNilSlice
+	tokens := []string{}
+	var sb strings.Builder
+	quoted := false
+	inToken := false
+	for _, r := range text {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			inToken = true
+		case !quoted && (r == ' ' || r == '\t'):
+			if inToken {
+				tokens = append(tokens, sb.String())
+				sb.Reset()
+				inToken = false
+			}
+		default:
+			sb.WriteRune(r)
+			inToken = true
+		}
+	}
+	if inToken {
+		tokens = append(tokens, sb.String())
+	}
+	return tokens, !quoted
+}
+
+const (
+	directiveName = "actdocs"
+	startKeyword  = "start"
+	endKeyword    = "end"
+)
@@ -0,0 +1,62 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSyntax_ParseDirective(t *testing.T) {
+	cases := []struct {
+		text     string
+		expected *Directive
+		ok       bool
+	}{
+		{
+			text:     "<!-- actdocs start -->",
+			expected: &Directive{Section: "", Start: true, Attributes: map[string]string{}, Format: "markdown"},
+			ok:       true,
+		},
+		{
+			text:     "<!-- actdocs inputs end -->",
+			expected: &Directive{Section: "inputs", Start: false, Attributes: map[string]string{}, Format: "markdown"},
+			ok:       true,
+		},
+		{
+			text:     `<!-- actdocs inputs start heading-offset=1 title="Action inputs" -->`,
+			expected: &Directive{Section: "inputs", Start: true, Attributes: map[string]string{"heading-offset": "1", "title": "Action inputs"}, Format: "markdown"},
+			ok:       true,
+		},
+		{
+			text:     "<!-- actdocs inputs end heading-offset=1 -->",
+			expected: nil,
+			ok:       false,
+		},
+		{
+			text:     `<!-- actdocs inputs start title="unterminated -->`,
+			expected: nil,
+			ok:       false,
+		},
+		{
+			text:     "<!-- prettier-ignore-start -->",
+			expected: nil,
+			ok:       false,
+		},
+		{
+			text:     "actdocs start",
+			expected: nil,
+			ok:       false,
+		},
+	}
+
+	for _, tc := range cases {
+		got, ok := MarkdownSyntax.ParseDirective(tc.text)
+		if ok != tc.ok {
+			t.Fatalf("%s: expected %t, got %t", tc.text, tc.ok, ok)
+		}
+
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("%s: diff: %s", tc.text, diff)
+		}
+	}
+}
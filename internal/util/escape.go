@@ -0,0 +1,129 @@
+package util
+
+import (
+	"net/url"
+	"strings"
+)
+
+// EscapeMarkdownCell returns the text safe in a markdown table cell, keeping the inline markdown.
+// The pipes and the HTML are escaped except the code spans, and the leading and trailing whitespaces are trimmed.
+// Multi-line text is wrapped in <pre> with <br> line breaks, since the table cells can't contain line breaks.
+func EscapeMarkdownCell(text string) string {
+	text = strings.TrimSpace(strings.ReplaceAll(text, "\r", ""))
+	if !strings.Contains(text, "\n") {
+		return escapeTablePipe(escapeMarkdownHtml(text))
+	}
+
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = escapeMarkdownHtml(line)
+	}
+	return escapeTablePipe(codeStart + strings.Join(lines, lineBreak) + codeEnd)
+}
+
+// EscapeMarkdownCode returns the code span in a markdown table cell, where the pipes are escaped.
+// Multi-line code is wrapped in <pre> with <br> line breaks, keeping the indentation,
+// where the backticks are encoded so as not to start code spans.
+func EscapeMarkdownCode(code string) string {
+	code = strings.TrimSuffix(strings.ReplaceAll(code, "\r", ""), "\n")
+	if !strings.Contains(code, "\n") {
+		return escapeTablePipe(MarkdownCodeSpan(code))
+	}
+	escaped := strings.ReplaceAll(htmlEscaper.Replace(code), "`", "&#96;")
+	return escapeTablePipe(codeStart + strings.ReplaceAll(escaped, "\n", lineBreak) + codeEnd)
+}
+
+// MarkdownCodeSpan returns the code span with the backticks longer than any backticks in the code.
+// The code is padded with spaces if it starts or ends with a backtick, or with a space on both sides,
+// since one space on both sides is stripped from the code span.
+// The empty code is rendered as the empty string literal, since the empty code span is invalid.
+func MarkdownCodeSpan(code string) string {
+	if code == "" {
+		return "`" + `""` + "`"
+	}
+	fence := strings.Repeat("`", longestRun(code, '`')+1)
+	padded := strings.HasPrefix(code, "`") || strings.HasSuffix(code, "`") ||
+		(strings.HasPrefix(code, " ") && strings.HasSuffix(code, " ") && strings.TrimSpace(code) != "")
+	if padded {
+		return fence + " " + code + " " + fence
+	}
+	return fence + code + fence
+}
+
+// MarkdownCodeFence returns the backticks of the fenced code block longer than any backticks in the code.
+func MarkdownCodeFence(code string) string {
+	return strings.Repeat("`", max(longestRun(code, '`')+1, 3))
+}
+
+// escapeMarkdownHtml escapes the HTML of the text except the code spans, which render the text as is.
+func escapeMarkdownHtml(text string) string {
+	var sb strings.Builder
+	for len(text) > 0 {
+		start := strings.Index(text, "`")
+		if start < 0 {
+			sb.WriteString(htmlEscaper.Replace(text))
+			break
+		}
+		sb.WriteString(htmlEscaper.Replace(text[:start]))
+		text = text[start:]
+
+		fence := text[:len(text)-len(strings.TrimLeft(text, "`"))]
+		end := closingFence(text[len(fence):], fence)
+		if end < 0 {
+			sb.WriteString(fence)
+			text = text[len(fence):]
+			continue
+		}
+		span := len(fence) + end + len(fence)
+		sb.WriteString(text[:span])
+		text = text[span:]
+	}
+	return sb.String()
+}
+
+// closingFence returns the index of the backticks of the same length as the fence, or -1 if not found.
+func closingFence(text string, fence string) int {
+	offset := 0
+	for {
+		index := strings.Index(text[offset:], fence)
+		if index < 0 {
+			return -1
+		}
+		index += offset
+		run := len(text[index:]) - len(strings.TrimLeft(text[index:], "`"))
+		if run == len(fence) {
+			return index
+		}
+		offset = index + run
+	}
+}
+
+// MarkdownLink returns the link, where the brackets and the HTML of the text are escaped,
+// and the destination is percent-encoded so that the spaces and the parentheses don't end it.
+func MarkdownLink(text string, destination string) string {
+	text = markdownLinkTextEscaper.Replace(EscapeMarkdownCell(text))
+	destination = markdownLinkDestinationEscaper.Replace((&url.URL{Path: destination}).EscapedPath())
+	return "[" + text + "](" + destination + ")"
+}
+
+func escapeTablePipe(text string) string {
+	return strings.ReplaceAll(text, TableSeparator, `\`+TableSeparator)
+}
+
+// longestRun returns the length of the longest run of the character in the text.
+func longestRun(text string, c rune) int {
+	length, longest := 0, 0
+	for _, r := range text {
+		if r == c {
+			length++
+			longest = max(longest, length)
+		} else {
+			length = 0
+		}
+	}
+	return longest
+}
+
+var htmlEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+var markdownLinkTextEscaper = strings.NewReplacer("[", `\[`, "]", `\]`)
+var markdownLinkDestinationEscaper = strings.NewReplacer("(", "%28", ")", "%29")
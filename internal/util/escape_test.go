@@ -0,0 +1,106 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEscapeMarkdownCell(t *testing.T) {
+	cases := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{name: "plain", text: "The description.", expected: "The description."},
+		{name: "inline markdown", text: "The **bold** [link](https://example.com).", expected: "The **bold** [link](https://example.com)."},
+		{name: "pipe", text: "foo | bar", expected: `foo \| bar`},
+		{name: "escaped pipe", text: `foo \| bar`, expected: `foo \\| bar`},
+		{name: "html", text: "<script>alert(1)</script>", expected: "&lt;script&gt;alert(1)&lt;/script&gt;"},
+		{name: "ampersand", text: "foo & bar &amp;", expected: "foo &amp; bar &amp;amp;"},
+		{name: "code span", text: "Use `a<b|c` here.", expected: "Use `a<b\\|c` here."},
+		{name: "double backtick code span", text: "Use ``a`<b`` here.", expected: "Use ``a`<b`` here."},
+		{name: "unmatched backtick", text: "It`s <ok>", expected: "It`s &lt;ok&gt;"},
+		{name: "mismatched backticks", text: "``a<b` c", expected: "``a&lt;b` c"},
+		{name: "whitespace", text: "  \t padded \n", expected: "padded"},
+		{name: "multi-line", text: "one |\n<two>\n`<three>`\n", expected: "<pre>one \\|<br>&lt;two&gt;<br>`<three>`</pre>"},
+		{name: "crlf", text: "one\r\ntwo\r\n", expected: "<pre>one<br>two</pre>"},
+		{name: "empty", text: "", expected: ""},
+	}
+
+	for _, tc := range cases {
+		got := EscapeMarkdownCell(tc.text)
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("%s: diff: %s", tc.name, diff)
+		}
+	}
+}
+
+func TestEscapeMarkdownCode(t *testing.T) {
+	cases := []struct {
+		name     string
+		code     string
+		expected string
+	}{
+		{name: "plain", code: "foo", expected: "`foo`"},
+		{name: "empty", code: "", expected: "`\"\"`"},
+		{name: "pipe", code: "a|b", expected: "`a\\|b`"},
+		{name: "html", code: "<b>", expected: "`<b>`"},
+		{name: "backtick", code: "a`b", expected: "``a`b``"},
+		{name: "backticks", code: "a``b`c", expected: "```a``b`c```"},
+		{name: "leading backtick", code: "`a", expected: "`` `a ``"},
+		{name: "trailing backtick", code: "a`", expected: "`` a` ``"},
+		{name: "spaces on both sides", code: " a ", expected: "`  a  `"},
+		{name: "leading space", code: " a", expected: "` a`"},
+		{name: "only spaces", code: "  ", expected: "`  `"},
+		{name: "multi-line", code: "{\n  \"key\": \"<value>|\"\n}\n", expected: "<pre>{<br>  \"key\": \"&lt;value&gt;\\|\"<br>}</pre>"},
+		{name: "multi-line backticks", code: "```\ncode `a`\n```\n", expected: "<pre>&#96;&#96;&#96;<br>code &#96;a&#96;<br>&#96;&#96;&#96;</pre>"},
+	}
+
+	for _, tc := range cases {
+		got := EscapeMarkdownCode(tc.code)
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("%s: diff: %s", tc.name, diff)
+		}
+	}
+}
+
+func TestMarkdownLink(t *testing.T) {
+	cases := []struct {
+		name        string
+		text        string
+		destination string
+		expected    string
+	}{
+		{name: "plain", text: "actions/setup/action.yml", destination: "actions/setup/action.yml", expected: "[actions/setup/action.yml](actions/setup/action.yml)"},
+		{name: "space", text: "my action/action.yml", destination: "my action/action.yml", expected: "[my action/action.yml](my%20action/action.yml)"},
+		{name: "parentheses", text: "a(b)/action.yml", destination: "a(b)/action.yml", expected: "[a(b)/action.yml](a%28b%29/action.yml)"},
+		{name: "brackets", text: "a]b", destination: "a]b", expected: "[a\\]b](a%5Db)"},
+		{name: "pipe and html", text: "a|<b>", destination: "a|<b>", expected: "[a\\|&lt;b&gt;](a%7C%3Cb%3E)"},
+	}
+
+	for _, tc := range cases {
+		got := MarkdownLink(tc.text, tc.destination)
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("%s: diff: %s", tc.name, diff)
+		}
+	}
+}
+
+func TestMarkdownCodeFence(t *testing.T) {
+	cases := []struct {
+		code     string
+		expected string
+	}{
+		{code: "foo", expected: "```"},
+		{code: "``` nested", expected: "````"},
+		{code: "a\n`````\nb", expected: "``````"},
+	}
+
+	for _, tc := range cases {
+		got := MarkdownCodeFence(tc.code)
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("%q: diff: %s", tc.code, diff)
+		}
+	}
+}
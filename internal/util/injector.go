@@ -0,0 +1,303 @@
+package util
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Document generates the content of directives.
+type Document interface {
+	// Supports reports whether the document can render the section, where empty section means all sections.
+	Supports(section string) bool
+	// RenderDirective returns the content of the directive with its attributes applied.
+	RenderDirective(directive *Directive) (string, error)
+}
+
+// DocumentResolver returns the document of the source attribute, where empty source means the default document.
+type DocumentResolver func(source string) (Document, error)
+
+// Injector replaces the content between directives with the content of the resolved documents.
+// Directives may be indented or quoted, and directives inside fenced code blocks and literal blocks are ignored.
+type Injector struct {
+	reader   *bufio.Reader
+	builder  strings.Builder
+	syntax   *Syntax
+	sections []string
+	resolver DocumentResolver
+	current  *openDirective
+	lineNo   int
+	fence    string
+	literal  int
+	newline  string
+}
+
+// openDirective is the start directive waiting for the end directive.
+type openDirective struct {
+	*Directive
+	text      string
+	lineNo    int
+	injecting bool
+}
+
+// NewInjector returns the injector handling the directives of the sections, and ignoring other directives.
+func NewInjector(template io.Reader, sections []string, resolver DocumentResolver) *Injector {
+	return NewSyntaxInjector(template, MarkdownSyntax, sections, resolver)
+}
+
+// NewSyntaxInjector returns the injector for the template written in the syntax.
+func NewSyntaxInjector(template io.Reader, syntax *Syntax, sections []string, resolver DocumentResolver) *Injector {
+	var builder strings.Builder
+	return &Injector{
+		reader:   bufio.NewReader(template),
+		builder:  builder,
+		syntax:   syntax,
+		sections: sections,
+		resolver: resolver,
+		current:  nil,
+		lineNo:   0,
+		fence:    "",
+		literal:  noLiteralBlock,
+		newline:  "",
+	}
+}
+
+// Inject returns the injected template, where the template is read line by line without the length limit,
+// and the bytes outside the directives are kept as is, including the line endings.
+// It returns errors with line numbers if the directives are unterminated, nested or mismatched.
+func (i *Injector) Inject() (string, error) {
+	var errs []error
+	for {
+		line, err := i.readLine()
+		if err != nil {
+			return "", err
+		}
+		if line == "" {
+			break
+		}
+
+		if err = i.injectLine(line); err != nil {
+			var structureErr *StructureError
+			if !errors.As(err, &structureErr) {
+				return "", err
+			}
+			errs = append(errs, err)
+		}
+	}
+
+	if i.current != nil {
+		errs = append(errs, i.structureError(i.current.lineNo, "unterminated marker %q", i.current.text))
+	}
+	if len(errs) > 0 {
+		return "", errors.Join(errs...)
+	}
+	return i.builder.String(), nil
+}
+
+// readLine returns the next line with the line ending, or empty string at the end of the template.
+func (i *Injector) readLine() (string, error) {
+	line, err := i.reader.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", fmt.Errorf("line %d: %w", i.lineNo+1, err)
+	}
+	if line != "" {
+		i.lineNo++
+		i.detectNewline(line)
+	}
+	return line, nil
+}
+
+func (i *Injector) injectLine(line string) error {
+	text := trimLineEnding(line)
+	directive, ok := i.parseLine(text)
+	switch {
+	case !ok:
+		if i.current == nil || !i.current.injecting {
+			i.builder.WriteString(line)
+		}
+	case directive.Start:
+		if i.current != nil {
+			return i.structureError(i.lineNo, "nested marker %q in marker at line %d", strings.TrimSpace(text), i.current.lineNo)
+		}
+		i.builder.WriteString(line)
+		return i.tryStartContentInjection(directive, text)
+	default:
+		if i.current == nil {
+			return i.structureError(i.lineNo, "end marker %q without start marker", strings.TrimSpace(text))
+		}
+		current := i.current
+		i.current = nil
+		i.builder.WriteString(line)
+		if directive.Section != current.Section {
+			return i.structureError(i.lineNo, "mismatched end marker %q for marker %q at line %d", strings.TrimSpace(text), current.text, current.lineNo)
+		}
+	}
+	return nil
+}
+
+func (i *Injector) structureError(lineNo int, format string, args ...any) error {
+	return &StructureError{LineNo: lineNo, Message: fmt.Sprintf(format, args...)}
+}
+
+// StructureError is the error of the unterminated, nested or mismatched directives.
+type StructureError struct {
+	LineNo  int
+	Message string
+}
+
+func (e *StructureError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.LineNo, e.Message)
+}
+
+func (i *Injector) tryStartContentInjection(directive *Directive, text string) error {
+	i.current = &openDirective{Directive: directive, text: strings.TrimSpace(text), lineNo: i.lineNo}
+	document, err := i.resolver(directive.Attributes[SourceAttribute])
+	if err != nil {
+		return fmt.Errorf("line %d: %s: %w", i.lineNo, i.current.text, err)
+	}
+	if !document.Supports(directive.Section) {
+		return nil
+	}
+
+	content, err := document.RenderDirective(directive)
+	if err != nil {
+		return fmt.Errorf("line %d: %s: %w", i.lineNo, i.current.text, err)
+	}
+
+	i.current.injecting = true
+	i.appendGeneratedMarkdown(content, linePrefix(text))
+	return nil
+}
+
+// appendGeneratedMarkdown appends the content surrounded by blank lines, where each line is prefixed
+// with the indentation or the quotation of the directive.
+func (i *Injector) appendGeneratedMarkdown(content string, prefix string) {
+	if content == "" {
+		return
+	}
+
+	i.appendPrefixedLine("", prefix)
+	for _, line := range strings.Split(content, "\n") {
+		i.appendPrefixedLine(line, prefix)
+	}
+	i.appendPrefixedLine("", prefix)
+}
+
+func (i *Injector) appendPrefixedLine(line string, prefix string) {
+	i.builder.WriteString(strings.TrimRight(prefix+line, " \t"))
+	i.builder.WriteString(i.newlineOrDefault())
+}
+
+// parseLine returns the directive of the handled sections, unless the line is in a fenced code block.
+func (i *Injector) parseLine(text string) (*Directive, bool) {
+	if i.inCodeBlock(text) {
+		return nil, false
+	}
+
+	directive, ok := i.syntax.ParseDirective(strings.TrimSpace(strings.TrimLeft(text, linePrefixChars)))
+	if !ok || !isHandledSection(i.sections, directive.Section) {
+		return nil, false
+	}
+	return directive, true
+}
+
+// inCodeBlock tracks fenced code blocks, and reports whether the line is in or delimits a code block.
+func (i *Injector) inCodeBlock(text string) bool {
+	if i.inLiteralBlock(text) {
+		return true
+	}
+
+	stripped := strings.TrimLeft(text, linePrefixChars)
+	marker := i.syntax.fenceMarker(stripped)
+	if i.fence == "" {
+		i.fence = marker
+		return marker != ""
+	}
+
+	rest := strings.TrimLeft(stripped, i.fence[:1])
+	if strings.HasPrefix(marker, i.fence) && strings.TrimSpace(rest) == "" {
+		i.fence = ""
+	}
+	return true
+}
+
+// inLiteralBlock tracks literal blocks, and reports whether the line is in a literal block.
+// The literal block continues while the lines are blank or indented deeper than the line starting it.
+func (i *Injector) inLiteralBlock(text string) bool {
+	if i.syntax.literalBlock == nil {
+		return false
+	}
+
+	if i.literal != noLiteralBlock {
+		indent := len(text) - len(strings.TrimLeft(text, " \t"))
+		if strings.TrimSpace(text) == "" || indent > i.literal {
+			return true
+		}
+	}
+	i.literal = i.syntax.literalBlock(text)
+	return false
+}
+
+// noLiteralBlock is the indentation of the literal block when not in a literal block.
+const noLiteralBlock = -1
+
+// detectNewline detects the line ending of the template from the first line.
+func (i *Injector) detectNewline(line string) {
+	if i.newline != "" || !strings.HasSuffix(line, "\n") {
+		return
+	}
+	if strings.HasSuffix(line, "\r\n") {
+		i.newline = "\r\n"
+	} else {
+		i.newline = "\n"
+	}
+}
+
+func (i *Injector) newlineOrDefault() string {
+	if i.newline == "" {
+		return "\n"
+	}
+	return i.newline
+}
+
+// HasDirective reports whether the template contains any start directive of the sections.
+func (s *Syntax) HasDirective(template string, sections []string) bool {
+	injector := NewSyntaxInjector(strings.NewReader(template), s, sections, nil)
+	for {
+		line, err := injector.readLine()
+		if err != nil || line == "" {
+			return false
+		}
+		if directive, ok := injector.parseLine(trimLineEnding(line)); ok && directive.Start {
+			return true
+		}
+	}
+}
+
+func trimLineEnding(line string) string {
+	return strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+}
+
+// linePrefix returns the indentation and the quotation such as "  " and "> ".
+func linePrefix(text string) string {
+	return text[:len(text)-len(strings.TrimLeft(text, linePrefixChars))]
+}
+
+func isHandledSection(sections []string, section string) bool {
+	if section == "" {
+		return true
+	}
+	for _, s := range sections {
+		if s == section {
+			return true
+		}
+	}
+	return false
+}
+
+const linePrefixChars = " \t>"
+
+// SourceAttribute is the directive attribute specifying the file to be documented.
+const SourceAttribute = "source"
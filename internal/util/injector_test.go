@@ -0,0 +1,206 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestInjector_Inject(t *testing.T) {
+	cases := []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{
+			name:     "default source",
+			template: "# Title\n<!-- actdocs inputs start -->\nfoo\n<!-- actdocs inputs end -->\n",
+			expected: "# Title\n<!-- actdocs inputs start -->\n\ndefault:inputs\n\n<!-- actdocs inputs end -->\n",
+		},
+		{
+			name:     "source attribute",
+			template: "<!-- actdocs start source=a.yml -->\n<!-- actdocs end -->\n<!-- actdocs outputs start source=b.yml -->\n<!-- actdocs outputs end -->\n",
+			expected: "<!-- actdocs start source=a.yml -->\n\na.yml:all\n\n<!-- actdocs end -->\n<!-- actdocs outputs start source=b.yml -->\n\nb.yml:outputs\n\n<!-- actdocs outputs end -->\n",
+		},
+		{
+			name:     "indentation and trailing whitespace",
+			template: "- item\n  <!-- actdocs inputs start -->  \n  <!-- actdocs inputs end -->\t\n",
+			expected: "- item\n  <!-- actdocs inputs start -->  \n\n  default:inputs\n\n  <!-- actdocs inputs end -->\t\n",
+		},
+		{
+			name:     "blockquote",
+			template: "> <!-- actdocs inputs start -->\n> <!-- actdocs inputs end -->\n",
+			expected: "> <!-- actdocs inputs start -->\n>\n> default:inputs\n>\n> <!-- actdocs inputs end -->\n",
+		},
+		{
+			name:     "CRLF",
+			template: "# Title\r\n<!-- actdocs start -->\r\n<!-- actdocs end -->\r\n",
+			expected: "# Title\r\n<!-- actdocs start -->\r\n\r\ndefault:all\r\n\r\n<!-- actdocs end -->\r\n",
+		},
+		{
+			name:     "fenced code block",
+			template: "```markdown\n<!-- actdocs start -->\n<!-- actdocs end -->\n```\n~~~~\n<!-- actdocs start -->\n~~~\n~~~~\n",
+			expected: "```markdown\n<!-- actdocs start -->\n<!-- actdocs end -->\n```\n~~~~\n<!-- actdocs start -->\n~~~\n~~~~\n",
+		},
+		{
+			name:     "without trailing newline",
+			template: "<!-- actdocs start -->\n<!-- actdocs end -->",
+			expected: "<!-- actdocs start -->\n\ndefault:all\n\n<!-- actdocs end -->",
+		},
+		{
+			name:     "unsupported section",
+			template: "<!-- actdocs secrets start -->\nfoo\n<!-- actdocs secrets end -->\n",
+			expected: "<!-- actdocs secrets start -->\nfoo\n<!-- actdocs secrets end -->\n",
+		},
+		{
+			name:     "unhandled section",
+			template: "<!-- actdocs catalog start -->\nfoo\n<!-- actdocs catalog end -->\n",
+			expected: "<!-- actdocs catalog start -->\nfoo\n<!-- actdocs catalog end -->\n",
+		},
+	}
+
+	for _, tc := range cases {
+		resolver := func(source string) (Document, error) {
+			if source == "" {
+				return &stubDocument{name: "default"}, nil
+			}
+			return &stubDocument{name: source}, nil
+		}
+
+		sut := NewInjector(strings.NewReader(tc.template), []string{"inputs", "secrets", "outputs"}, resolver)
+		got, err := sut.Inject()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.name, err)
+		}
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("%s: diff: %s", tc.name, diff)
+		}
+	}
+}
+
+func TestInjector_InjectWithError(t *testing.T) {
+	cases := []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{
+			name:     "resolver error",
+			template: "<!-- actdocs start source=a.yml -->\n<!-- actdocs end -->\n",
+			expected: "line 1: <!-- actdocs start source=a.yml -->: not found: a.yml",
+		},
+		{
+			name:     "unterminated",
+			template: "# Title\n<!-- actdocs inputs start -->\nfoo\n",
+			expected: `line 2: unterminated marker "<!-- actdocs inputs start -->"`,
+		},
+		{
+			name:     "nested",
+			template: "<!-- actdocs start -->\n<!-- actdocs inputs start -->\n<!-- actdocs end -->\n",
+			expected: `line 2: nested marker "<!-- actdocs inputs start -->" in marker at line 1`,
+		},
+		{
+			name:     "mismatched",
+			template: "<!-- actdocs inputs start -->\n<!-- actdocs outputs end -->\n",
+			expected: `line 2: mismatched end marker "<!-- actdocs outputs end -->" for marker "<!-- actdocs inputs start -->" at line 1`,
+		},
+		{
+			name:     "end without start",
+			template: "<!-- actdocs inputs end -->\n<!-- actdocs end -->\n",
+			expected: "line 1: end marker \"<!-- actdocs inputs end -->\" without start marker\nline 2: end marker \"<!-- actdocs end -->\" without start marker",
+		},
+		{
+			name:     "unsupported section",
+			template: "<!-- actdocs secrets start -->\nfoo\n",
+			expected: `line 1: unterminated marker "<!-- actdocs secrets start -->"`,
+		},
+	}
+
+	for _, tc := range cases {
+		resolver := func(source string) (Document, error) {
+			if source != "" {
+				return nil, fmt.Errorf("not found: %s", source)
+			}
+			return &stubDocument{name: "default"}, nil
+		}
+
+		sut := NewInjector(strings.NewReader(tc.template), []string{"inputs", "secrets", "outputs"}, resolver)
+		_, err := sut.Inject()
+		if err == nil || err.Error() != tc.expected {
+			t.Errorf("%s: expected: %s, but got: %v", tc.name, tc.expected, err)
+		}
+	}
+}
+
+func TestInjector_InjectWithLongLine(t *testing.T) {
+	long := strings.Repeat("a", 1024*1024)
+	template := long + "\n<!-- actdocs start -->\n" + long + "\n<!-- actdocs end -->\n \t" + long + "\r"
+	expected := long + "\n<!-- actdocs start -->\n\ndefault:all\n\n<!-- actdocs end -->\n \t" + long + "\r"
+
+	sut := NewInjector(strings.NewReader(template), []string{}, func(string) (Document, error) { return &stubDocument{name: "default"}, nil })
+	got, err := sut.Inject()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != expected {
+		t.Errorf("expected the bytes outside the directives are kept as is")
+	}
+}
+
+func TestInjector_InjectWithReadError(t *testing.T) {
+	template := io.MultiReader(strings.NewReader("# Title\n<!-- actdocs start -->\n"), iotest.ErrReader(errors.New("read error")))
+
+	sut := NewInjector(template, []string{}, func(string) (Document, error) { return &stubDocument{name: "default"}, nil })
+	_, err := sut.Inject()
+	expected := "line 3: read error"
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected: %s, but got: %v", expected, err)
+	}
+}
+
+func TestSyntax_HasDirective(t *testing.T) {
+	cases := []struct {
+		template string
+		expected bool
+	}{
+		{
+			template: "# Title\n  <!-- actdocs inputs start -->\r\n",
+			expected: true,
+		},
+		{
+			template: "```\n<!-- actdocs start -->\n```\n",
+			expected: false,
+		},
+		{
+			template: "<!-- actdocs catalog start -->\n",
+			expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		got := MarkdownSyntax.HasDirective(tc.template, []string{"inputs"})
+		if got != tc.expected {
+			t.Errorf("%q: expected: %t, but got: %t", tc.template, tc.expected, got)
+		}
+	}
+}
+
+type stubDocument struct {
+	name string
+}
+
+func (d *stubDocument) Supports(section string) bool {
+	return section != "secrets"
+}
+
+func (d *stubDocument) RenderDirective(directive *Directive) (string, error) {
+	if directive.Section == "" {
+		return d.name + ":all", nil
+	}
+	return d.name + ":" + directive.Section, nil
+}
@@ -0,0 +1,28 @@
+package util
+
+import "regexp"
+
+// DetectKind returns the kind of the yaml, or empty string if it is neither Custom Action nor Reusable Workflow.
+func DetectKind(yaml []byte) string {
+	if actionPattern.Match(yaml) {
+		return ActionKind
+	} else if workflowPattern.Match(yaml) {
+		return WorkflowKind
+	}
+	return ""
+}
+
+const (
+	ActionKind   = "action"
+	WorkflowKind = "workflow"
+)
+
+const (
+	ActionRegex   = `(?m)^[\s]*runs:`
+	WorkflowRegex = `(?m)^[\s]*workflow_call:`
+)
+
+var (
+	actionPattern   = regexp.MustCompile(ActionRegex)
+	workflowPattern = regexp.MustCompile(WorkflowRegex)
+)
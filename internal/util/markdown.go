@@ -0,0 +1,14 @@
+package util
+
+// Heading returns the markdown heading, where the level is clamped between 1 and 6.
+func Heading(level int, title string) string {
+	return MarkdownSyntax.Heading(level, title)
+}
+
+const (
+	MinHeadingLevel = 1
+	MaxHeadingLevel = 6
+)
+
+// DefaultHeadingLevel is the heading level of sections without offset.
+const DefaultHeadingLevel = 2
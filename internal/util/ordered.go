@@ -0,0 +1,58 @@
+package util
+
+import (
+	"fmt"
+	"iter"
+
+	"gopkg.in/yaml.v2"
+)
+
+// OrderedMap is the YAML mapping which keeps the keys in the declaration order.
+type OrderedMap[V any] struct {
+	Keys   []string
+	Values map[string]V
+}
+
+func NewOrderedMap[V any]() *OrderedMap[V] {
+	return &OrderedMap[V]{
+		Keys:   []string{},
+		Values: map[string]V{},
+	}
+}
+
+func (m *OrderedMap[V]) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	values := map[string]V{}
+	if err := unmarshal(&values); err != nil {
+		return err
+	}
+	var slice yaml.MapSlice
+	if err := unmarshal(&slice); err != nil {
+		return err
+	}
+
+	result := &OrderedMap[V]{Keys: []string{}, Values: values}
+	seen := map[string]bool{}
+	for _, item := range slice {
+		key := fmt.Sprint(item.Key)
+		if _, ok := values[key]; ok && !seen[key] {
+			result.Keys = append(result.Keys, key)
+			seen[key] = true
+		}
+	}
+	*m = *result
+	return nil
+}
+
+// All returns the iterator over the keys and the values in the declaration order, where nil means empty.
+func (m *OrderedMap[V]) All() iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		if m == nil {
+			return
+		}
+		for _, key := range m.Keys {
+			if !yield(key, m.Values[key]) {
+				return
+			}
+		}
+	}
+}
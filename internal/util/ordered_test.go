@@ -0,0 +1,38 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"gopkg.in/yaml.v2"
+)
+
+func TestOrderedMap_UnmarshalYAML(t *testing.T) {
+	var got struct {
+		Items *OrderedMap[*string] `yaml:"items"`
+	}
+	fixture := "items:\n  zulu: z\n  alpha:\n  5: five\n  mike: 1.0\n"
+	if err := yaml.Unmarshal([]byte(fixture), &got); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	keys := []string{}
+	values := []string{}
+	for key, value := range got.Items.All() {
+		keys = append(keys, key)
+		values = append(values, NewNullString(value).StringOrUpperNA())
+	}
+	if diff := cmp.Diff(keys, []string{"zulu", "alpha", "5", "mike"}); diff != "" {
+		t.Errorf("keys diff: %s", diff)
+	}
+	if diff := cmp.Diff(values, []string{"z", "N/A", "five", "1.0"}); diff != "" {
+		t.Errorf("values diff: %s", diff)
+	}
+}
+
+func TestOrderedMap_AllWithNil(t *testing.T) {
+	var sut *OrderedMap[string]
+	for key := range sut.All() {
+		t.Errorf("unexpected key: %s", key)
+	}
+}
@@ -0,0 +1,102 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Skeleton returns the template having the title and the all-in-one directive.
+// The description isn't included, since the directive renders it.
+func (s *Syntax) Skeleton(title string) string {
+	var sb strings.Builder
+	sb.WriteString(s.Heading(MinHeadingLevel, title))
+	sb.WriteString("\n\n")
+	sb.WriteString(s.directiveBlock("\n"))
+	return sb.String()
+}
+
+// InsertDirective returns the template with the all-in-one directive inserted after the heading,
+// or appended at the end if the heading is empty.
+// The heading matches such as "## Usage" or "Usage", and returns error if not found.
+func (s *Syntax) InsertDirective(template string, heading string) (string, error) {
+	newline := "\n"
+	if strings.Contains(template, "\r\n") {
+		newline = "\r\n"
+	}
+
+	if heading == "" {
+		return s.appendDirective(template, newline), nil
+	}
+
+	var sb strings.Builder
+	lines := strings.SplitAfter(template, "\n")
+	for i := range lines {
+		if !s.matchHeading(trimLineEnding(lines[i]), heading) {
+			continue
+		}
+		if len(s.HeadingAdornments) != 0 {
+			if i+1 >= len(lines) || !s.isAdornment(lines[i+1]) {
+				continue
+			}
+			i++
+		}
+		line := lines[i]
+
+		for _, before := range lines[:i+1] {
+			sb.WriteString(before)
+		}
+		if !strings.HasSuffix(line, "\n") {
+			sb.WriteString(newline)
+		}
+		sb.WriteString(newline)
+		sb.WriteString(s.directiveBlock(newline))
+		if rest := lines[i+1:]; len(rest) > 0 && strings.TrimSpace(rest[0]) != "" {
+			sb.WriteString(newline)
+		}
+		for _, after := range lines[i+1:] {
+			sb.WriteString(after)
+		}
+		return sb.String(), nil
+	}
+	return "", fmt.Errorf("not found heading: %s", heading)
+}
+
+func (s *Syntax) appendDirective(template string, newline string) string {
+	if strings.TrimSpace(template) == "" {
+		return s.directiveBlock(newline)
+	}
+	if !strings.HasSuffix(template, "\n") {
+		template += newline
+	}
+	return template + newline + s.directiveBlock(newline)
+}
+
+// matchHeading reports whether the text is the heading, where the heading may omit the leading marker such as "#".
+// The titles underlined by the adornments match the text as is.
+func (s *Syntax) matchHeading(text string, heading string) bool {
+	text = strings.TrimSpace(text)
+	if len(s.HeadingAdornments) != 0 {
+		return text != "" && text == strings.TrimSpace(heading)
+	}
+	if !strings.HasPrefix(text, s.HeadingMarker) {
+		return false
+	}
+	if text == strings.TrimSpace(heading) {
+		return true
+	}
+	return strings.TrimSpace(strings.TrimLeft(text, s.HeadingMarker)) == strings.TrimSpace(heading)
+}
+
+func (s *Syntax) directiveBlock(newline string) string {
+	return s.BeginAllDirective() + newline + s.EndAllDirective() + newline
+}
+
+// BeginAllDirective returns the start directive of all sections such as "<!-- actdocs start -->".
+func (s *Syntax) BeginAllDirective() string {
+	return s.Marker("", startKeyword)
+}
+
+// EndAllDirective returns the end directive of all sections such as "<!-- actdocs end -->".
+func (s *Syntax) EndAllDirective() string {
+	return s.Marker("", endKeyword)
+}
@@ -0,0 +1,82 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSyntax_Skeleton(t *testing.T) {
+	cases := []struct {
+		title    string
+		expected string
+	}{
+		{
+			title:    "Example",
+			expected: "# Example\n\n<!-- actdocs start -->\n<!-- actdocs end -->\n",
+		},
+		{
+			title:    "action.yml",
+			expected: "# action.yml\n\n<!-- actdocs start -->\n<!-- actdocs end -->\n",
+		},
+	}
+
+	for _, tc := range cases {
+		got := MarkdownSyntax.Skeleton(tc.title)
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("%s: diff: %s", tc.title, diff)
+		}
+	}
+}
+
+func TestSyntax_InsertDirective(t *testing.T) {
+	cases := []struct {
+		name     string
+		template string
+		heading  string
+		expected string
+	}{
+		{
+			name:     "end",
+			template: "# Title\n\nText",
+			heading:  "",
+			expected: "# Title\n\nText\n\n<!-- actdocs start -->\n<!-- actdocs end -->\n",
+		},
+		{
+			name:     "empty",
+			template: "",
+			heading:  "",
+			expected: "<!-- actdocs start -->\n<!-- actdocs end -->\n",
+		},
+		{
+			name:     "after heading",
+			template: "# Title\n\n## Usage\n\nText\n",
+			heading:  "## Usage",
+			expected: "# Title\n\n## Usage\n\n<!-- actdocs start -->\n<!-- actdocs end -->\n\nText\n",
+		},
+		{
+			name:     "after heading without hash",
+			template: "# Title\r\n## Usage\r\nText\r\n",
+			heading:  "Usage",
+			expected: "# Title\r\n## Usage\r\n\r\n<!-- actdocs start -->\r\n<!-- actdocs end -->\r\n\r\nText\r\n",
+		},
+	}
+
+	for _, tc := range cases {
+		got, err := MarkdownSyntax.InsertDirective(tc.template, tc.heading)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.name, err)
+		}
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("%s: diff: %s", tc.name, diff)
+		}
+	}
+}
+
+func TestSyntax_InsertDirectiveWithError(t *testing.T) {
+	_, err := MarkdownSyntax.InsertDirective("# Title\n\nUsage\n", "Usage")
+	expected := "not found heading: Usage"
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected: %s, but got: %v", expected, err)
+	}
+}
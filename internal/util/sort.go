@@ -0,0 +1,36 @@
+package util
+
+import "sort"
+
+// SortByRequiredAndName returns the items sorted by required first, then by name.
+func SortByRequiredAndName[T any](items []T, name func(T) string, required func(T) bool) []T {
+	//goland:noinspection GoPreferNilSlice
+	requiredItems := []T{}
+	//goland:noinspection GoPreferNilSlice
+	notRequiredItems := []T{}
+	for _, item := range items {
+		if required(item) {
+			requiredItems = append(requiredItems, item)
+		} else {
+			notRequiredItems = append(notRequiredItems, item)
+		}
+	}
+
+	SortByName(requiredItems, name)
+	SortByName(notRequiredItems, name)
+	return append(requiredItems, notRequiredItems...)
+}
+
+// SortByName sorts the items by name in place.
+func SortByName[T any](items []T, name func(T) string) {
+	sort.Slice(items, func(i, j int) bool {
+		return name(items[i]) < name(items[j])
+	})
+}
+
+// SortByRequired sorts the items by required in place, keeping the order among required and not required items.
+func SortByRequired[T any](items []T, required func(T) bool) {
+	sort.SliceStable(items, func(i, j int) bool {
+		return required(items[i]) && !required(items[j])
+	})
+}
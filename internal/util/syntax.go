@@ -0,0 +1,153 @@
+package util
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Syntax is the markup language of the templates, which defines the comments of the directives,
+// the headings and the code blocks ignored by the injector.
+type Syntax struct {
+	// Format is the output format rendering the directives such as "markdown".
+	Format        string
+	Extensions    []string
+	CommentPrefix string
+	CommentSuffix string
+	HeadingMarker string
+	// HeadingAdornments are the characters underlining the titles by level instead of HeadingMarker, such as "=".
+	HeadingAdornments []string
+	fenceMarker       func(text string) string
+	// literalBlock returns the indentation of the line starting the block of the deeper indented lines such as "::",
+	// or noLiteralBlock if the line doesn't start it. It's nil if not supported.
+	literalBlock func(line string) int
+}
+
+var MarkdownSyntax = &Syntax{
+	Format:        "markdown",
+	Extensions:    []string{".md", ".markdown"},
+	CommentPrefix: "<!--",
+	CommentSuffix: "-->",
+	HeadingMarker: "#",
+	fenceMarker:   markdownFenceMarker,
+}
+
+var AsciidocSyntax = &Syntax{
+	Format:        "asciidoc",
+	Extensions:    []string{".adoc", ".asciidoc", ".asc"},
+	CommentPrefix: "//",
+	CommentSuffix: "",
+	HeadingMarker: "=",
+	fenceMarker:   asciidocFenceMarker,
+}
+
+var RstSyntax = &Syntax{
+	Format:            "rst",
+	Extensions:        []string{".rst", ".rest"},
+	CommentPrefix:     "..",
+	CommentSuffix:     "",
+	HeadingAdornments: []string{"=", "-", "~", "^", "\"", "'"},
+	fenceMarker:       noFenceMarker,
+	literalBlock:      rstLiteralBlock,
+}
+
+var Syntaxes = []*Syntax{MarkdownSyntax, AsciidocSyntax, RstSyntax}
+
+// SyntaxOf returns the syntax of the file by the extension, or markdown if the extension is unknown.
+func SyntaxOf(path string) *Syntax {
+	ext := strings.ToLower(filepath.Ext(path))
+	for _, syntax := range Syntaxes {
+		for _, extension := range syntax.Extensions {
+			if ext == extension {
+				return syntax
+			}
+		}
+	}
+	return MarkdownSyntax
+}
+
+// Marker returns the directive such as "<!-- actdocs inputs start -->", where empty section means all sections.
+func (s *Syntax) Marker(section string, keyword string) string {
+	tokens := []string{s.CommentPrefix, directiveName}
+	if section != "" {
+		tokens = append(tokens, section)
+	}
+	tokens = append(tokens, keyword)
+	if s.CommentSuffix != "" {
+		tokens = append(tokens, s.CommentSuffix)
+	}
+	return strings.Join(tokens, " ")
+}
+
+// Heading returns the heading, where the level is clamped between 1 and 6.
+func (s *Syntax) Heading(level int, title string) string {
+	level = max(MinHeadingLevel, min(level, MaxHeadingLevel))
+	if len(s.HeadingAdornments) != 0 {
+		return title + "\n" + strings.Repeat(s.HeadingAdornments[level-1], max(utf8.RuneCountInString(title), 1))
+	}
+	return strings.Repeat(s.HeadingMarker, level) + " " + title
+}
+
+// isAdornment reports whether the text underlines the titles such as "-----".
+func (s *Syntax) isAdornment(text string) bool {
+	text = strings.TrimSpace(text)
+	for _, c := range s.HeadingAdornments {
+		if text != "" && strings.Trim(text, c) == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// markdownFenceMarker returns the opening characters of a fenced code block such as "```", or empty string.
+func markdownFenceMarker(text string) string {
+	for _, c := range []string{"`", "~"} {
+		length := len(text) - len(strings.TrimLeft(text, c))
+		if length >= 3 {
+			return text[:length]
+		}
+	}
+	return ""
+}
+
+// asciidocFenceMarker returns the delimiter of a listing, literal, comment or passthrough block
+// such as "----", or the opening characters of a fenced code block such as "```", or empty string.
+func asciidocFenceMarker(text string) string {
+	delimiter := strings.TrimRight(text, " \t")
+	for _, c := range []string{"-", ".", "/", "+"} {
+		if len(delimiter) >= 4 && strings.Trim(delimiter, c) == "" {
+			return delimiter
+		}
+	}
+	return markdownFenceMarker(text)
+}
+
+// rstLiteralBlock returns the indentation of the line starting the literal block such as "Example::",
+// or the code block directive such as ".. code-block:: yaml", where the list item is indented by the bullet.
+// Other directives such as ".. note::" aren't literal, since their contents are parsed.
+func rstLiteralBlock(line string) int {
+	text := strings.TrimSpace(line)
+	indent := len(line) - len(strings.TrimLeft(line, " \t"))
+	if strings.HasPrefix(text, "..") {
+		for _, name := range []string{"code-block", "code", "sourcecode"} {
+			if strings.HasPrefix(text, ".. "+name+"::") {
+				return indent
+			}
+		}
+		return noLiteralBlock
+	}
+
+	if !strings.HasSuffix(text, "::") {
+		return noLiteralBlock
+	}
+	return indent + len(rstBulletPattern.FindString(text))
+}
+
+// rstBulletPattern matches the bullet of the list item such as "- " and "1. ".
+var rstBulletPattern = regexp.MustCompile(`^(?:[-*+]|#\.|\d+[.)]|\(\d+\))\s+`)
+
+// noFenceMarker returns empty string, for the syntax without fenced code blocks.
+func noFenceMarker(string) string {
+	return ""
+}
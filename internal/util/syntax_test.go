@@ -0,0 +1,250 @@
+package util
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSyntaxOf(t *testing.T) {
+	cases := []struct {
+		path     string
+		expected *Syntax
+	}{
+		{path: "README.md", expected: MarkdownSyntax},
+		{path: "docs/README.markdown", expected: MarkdownSyntax},
+		{path: "README.adoc", expected: AsciidocSyntax},
+		{path: "docs/README.ASCIIDOC", expected: AsciidocSyntax},
+		{path: "README.asc", expected: AsciidocSyntax},
+		{path: "docs/index.rst", expected: RstSyntax},
+		{path: "README", expected: MarkdownSyntax},
+	}
+
+	for _, tc := range cases {
+		got := SyntaxOf(tc.path)
+		if got != tc.expected {
+			t.Errorf("%s: expected: %s, but got: %s", tc.path, tc.expected.Format, got.Format)
+		}
+	}
+}
+
+func TestSyntax_Marker(t *testing.T) {
+	cases := []struct {
+		syntax   *Syntax
+		section  string
+		keyword  string
+		expected string
+	}{
+		{syntax: MarkdownSyntax, section: "inputs", keyword: "start", expected: "<!-- actdocs inputs start -->"},
+		{syntax: MarkdownSyntax, section: "", keyword: "end", expected: "<!-- actdocs end -->"},
+		{syntax: AsciidocSyntax, section: "inputs", keyword: "start", expected: "// actdocs inputs start"},
+		{syntax: AsciidocSyntax, section: "", keyword: "end", expected: "// actdocs end"},
+		{syntax: RstSyntax, section: "inputs", keyword: "start", expected: ".. actdocs inputs start"},
+		{syntax: RstSyntax, section: "", keyword: "end", expected: ".. actdocs end"},
+	}
+
+	for _, tc := range cases {
+		got := tc.syntax.Marker(tc.section, tc.keyword)
+		if got != tc.expected {
+			t.Errorf("expected: %s, but got: %s", tc.expected, got)
+		}
+	}
+}
+
+func TestSyntax_HeadingWithAsciidoc(t *testing.T) {
+	if got := AsciidocSyntax.Heading(2, "Inputs"); got != "== Inputs" {
+		t.Errorf("unexpected heading: %s", got)
+	}
+	if got := AsciidocSyntax.Heading(9, "Inputs"); got != "====== Inputs" {
+		t.Errorf("unexpected heading: %s", got)
+	}
+}
+
+func TestInjector_InjectWithAsciidoc(t *testing.T) {
+	cases := []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{
+			name:     "all sections",
+			template: "= Title\n\n// actdocs start\nfoo\n// actdocs end\n",
+			expected: "= Title\n\n// actdocs start\n\ndefault:all\n\n// actdocs end\n",
+		},
+		{
+			name:     "section with attributes",
+			template: "// actdocs inputs start source=a.yml\n// actdocs inputs end\n",
+			expected: "// actdocs inputs start source=a.yml\n\na.yml:inputs\n\n// actdocs inputs end\n",
+		},
+		{
+			name:     "listing block",
+			template: "----\n// actdocs start\n// actdocs end\n----\n",
+			expected: "----\n// actdocs start\n// actdocs end\n----\n",
+		},
+		{
+			name:     "markdown comment",
+			template: "<!-- actdocs start -->\n<!-- actdocs end -->\n",
+			expected: "<!-- actdocs start -->\n<!-- actdocs end -->\n",
+		},
+	}
+
+	for _, tc := range cases {
+		resolver := func(source string) (Document, error) {
+			if source == "" {
+				return &stubDocument{name: "default"}, nil
+			}
+			return &stubDocument{name: source}, nil
+		}
+
+		sut := NewSyntaxInjector(strings.NewReader(tc.template), AsciidocSyntax, []string{"inputs", "secrets", "outputs"}, resolver)
+		got, err := sut.Inject()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.name, err)
+		}
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("%s: diff: %s", tc.name, diff)
+		}
+	}
+}
+
+func TestSyntax_SkeletonWithAsciidoc(t *testing.T) {
+	got := AsciidocSyntax.Skeleton("Example")
+	expected := "= Example\n\n// actdocs start\n// actdocs end\n"
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("diff: %s", diff)
+	}
+}
+
+func TestSyntax_InsertDirectiveWithAsciidoc(t *testing.T) {
+	got, err := AsciidocSyntax.InsertDirective("= Title\n\n== Usage\n\nText\n", "== Usage")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := "= Title\n\n== Usage\n\n// actdocs start\n// actdocs end\n\nText\n"
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("diff: %s", diff)
+	}
+}
+
+func TestSyntax_HeadingWithRst(t *testing.T) {
+	cases := []struct {
+		level    int
+		title    string
+		expected string
+	}{
+		{level: 1, title: "Example", expected: "Example\n======="},
+		{level: 2, title: "Inputs", expected: "Inputs\n------"},
+		{level: 3, title: "日本語", expected: "日本語\n~~~"},
+		{level: 9, title: "Inputs", expected: "Inputs\n''''''"},
+	}
+
+	for _, tc := range cases {
+		got := RstSyntax.Heading(tc.level, tc.title)
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("%d %s: diff: %s", tc.level, tc.title, diff)
+		}
+	}
+}
+
+func TestInjector_InjectWithRst(t *testing.T) {
+	cases := []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{
+			name:     "all sections",
+			template: "Title\n=====\n\n.. actdocs start\nfoo\n.. actdocs end\n",
+			expected: "Title\n=====\n\n.. actdocs start\n\ndefault:all\n\n.. actdocs end\n",
+		},
+		{
+			name:     "section with attributes",
+			template: ".. actdocs inputs start source=a.yml\n.. actdocs inputs end\n",
+			expected: ".. actdocs inputs start source=a.yml\n\na.yml:inputs\n\n.. actdocs inputs end\n",
+		},
+		{
+			name:     "other comments",
+			template: ".. note:: actdocs start\n.. _actdocs:\n",
+			expected: ".. note:: actdocs start\n.. _actdocs:\n",
+		},
+		{
+			name:     "literal block",
+			template: "Example::\n\n  .. actdocs start\n\n  .. actdocs end\n\n.. actdocs start\n.. actdocs end\n",
+			expected: "Example::\n\n  .. actdocs start\n\n  .. actdocs end\n\n.. actdocs start\n\ndefault:all\n\n.. actdocs end\n",
+		},
+		{
+			name:     "code block directive",
+			template: ".. code-block:: rst\n\n   .. actdocs start\n   .. actdocs end\n\n.. actdocs start\n.. actdocs end\n",
+			expected: ".. code-block:: rst\n\n   .. actdocs start\n   .. actdocs end\n\n.. actdocs start\n\ndefault:all\n\n.. actdocs end\n",
+		},
+		{
+			name:     "indented literal block",
+			template: "- Example::\n\n    .. actdocs start\n\n  .. actdocs start\n  .. actdocs end\n",
+			expected: "- Example::\n\n    .. actdocs start\n\n  .. actdocs start\n\n  default:all\n\n  .. actdocs end\n",
+		},
+		{
+			name:     "directive contents",
+			template: ".. note::\n\n   .. actdocs start\n   .. actdocs end\n",
+			expected: ".. note::\n\n   .. actdocs start\n\n   default:all\n\n   .. actdocs end\n",
+		},
+	}
+
+	for _, tc := range cases {
+		resolver := func(source string) (Document, error) {
+			if source == "" {
+				return &stubDocument{name: "default"}, nil
+			}
+			return &stubDocument{name: source}, nil
+		}
+
+		sut := NewSyntaxInjector(strings.NewReader(tc.template), RstSyntax, []string{"inputs", "secrets", "outputs"}, resolver)
+		got, err := sut.Inject()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.name, err)
+		}
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("%s: diff: %s", tc.name, diff)
+		}
+	}
+}
+
+func TestSyntax_InsertDirectiveWithRst(t *testing.T) {
+	cases := []struct {
+		name     string
+		template string
+		heading  string
+		expected string
+	}{
+		{
+			name:     "after underlined heading",
+			template: "Title\n=====\n\nUsage\n-----\n\nText\n",
+			heading:  "Usage",
+			expected: "Title\n=====\n\nUsage\n-----\n\n.. actdocs start\n.. actdocs end\n\nText\n",
+		},
+		{
+			name:     "skip text without underline",
+			template: "Usage\n\nUsage\n~~~~~\n",
+			heading:  "Usage",
+			expected: "Usage\n\nUsage\n~~~~~\n\n.. actdocs start\n.. actdocs end\n",
+		},
+	}
+
+	for _, tc := range cases {
+		got, err := RstSyntax.InsertDirective(tc.template, tc.heading)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.name, err)
+		}
+		if diff := cmp.Diff(got, tc.expected); diff != "" {
+			t.Errorf("%s: diff: %s", tc.name, diff)
+		}
+	}
+}
+
+func TestSyntax_SkeletonWithRst(t *testing.T) {
+	got := RstSyntax.Skeleton("Example")
+	expected := "Example\n=======\n\n.. actdocs start\n.. actdocs end\n"
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("diff: %s", diff)
+	}
+}
@@ -0,0 +1,77 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Column defines a markdown table column, which generates the header, separator and cells.
+type Column[T any] struct {
+	Key   string
+	Title string
+	Align Align
+	// Separator overrides the separator generated from Title and Align.
+	Separator string
+	Value     func(item T) string
+}
+
+type Align int
+
+const (
+	AlignLeft Align = iota
+	AlignCenter
+	AlignRight
+)
+
+// SelectColumns returns the columns in the order of the keys, ignoring unknown keys.
+func SelectColumns[T any](columns []*Column[T], keys []string) []*Column[T] {
+	//goland:noinspection GoPreferNilSlice
+	result := []*Column[T]{}
+	for _, key := range keys {
+		for _, column := range columns {
+			if column.Key == key {
+				result = append(result, column)
+			}
+		}
+	}
+	return result
+}
+
+func TableHeader[T any](columns []*Column[T]) string {
+	str := TableSeparator
+	for _, column := range columns {
+		str += fmt.Sprintf(" %s %s", column.Title, TableSeparator)
+	}
+	return str
+}
+
+func TableColumnSeparator[T any](columns []*Column[T]) string {
+	str := TableSeparator
+	for _, column := range columns {
+		str += fmt.Sprintf(" %s %s", column.separator(), TableSeparator)
+	}
+	return str
+}
+
+func TableRow[T any](columns []*Column[T], item T) string {
+	str := TableSeparator
+	for _, column := range columns {
+		str += fmt.Sprintf(" %s %s", column.Value(item), TableSeparator)
+	}
+	return str
+}
+
+func (c *Column[T]) separator() string {
+	if c.Separator != "" {
+		return c.Separator
+	}
+
+	width := max(len(c.Title), 3)
+	switch c.Align {
+	case AlignCenter:
+		return ":" + strings.Repeat("-", width-2) + ":"
+	case AlignRight:
+		return strings.Repeat("-", width-1) + ":"
+	}
+	return ":" + strings.Repeat("-", width-1)
+}
@@ -2,8 +2,6 @@ package util
 
 import (
 	"encoding/json"
-	"fmt"
-	"strings"
 )
 
 const TableSeparator = "|"
@@ -34,12 +32,17 @@ func (s *NullString) MarshalJSON() ([]byte, error) {
 	return json.Marshal(nil)
 }
 
+func (s *NullString) MarshalYAML() (interface{}, error) {
+	if s.Valid {
+		return s.Value, nil
+	}
+	return nil, nil
+}
+
+// StringOrEmpty returns the value escaped for the markdown table cell, or empty string if null.
 func (s *NullString) StringOrEmpty() string {
 	if s.Valid {
-		if strings.Contains(s.Value, "\n") {
-			return s.sanitizeString()
-		}
-		return s.Value
+		return EscapeMarkdownCell(s.Value)
 	}
 	return emptyString
 }
@@ -51,9 +54,10 @@ func (s *NullString) StringOrUpperNA() string {
 	return UpperNAString
 }
 
+// QuoteStringOrLowerNA returns the value as code escaped for the markdown table cell, or "n/a" if null.
 func (s *NullString) QuoteStringOrLowerNA() string {
 	if s.Valid {
-		return s.quoteString()
+		return EscapeMarkdownCode(s.Value)
 	}
 	return LowerNAString
 }
@@ -73,21 +77,6 @@ func (s *NullString) IsValid() bool {
 	return s.Valid
 }
 
-func (s *NullString) quoteString() string {
-	if strings.Contains(s.Value, "\n") {
-		return s.sanitizeString()
-	}
-	return "`" + s.Value + "`"
-}
-
-func (s *NullString) sanitizeString() string {
-	var str string
-	str = strings.TrimSuffix(s.Value, "\n")
-	str = strings.ReplaceAll(str, "\n", lineBreak)
-	str = strings.ReplaceAll(str, "\r", "")
-	return fmt.Sprintf("%s%s%s", codeStart, str, codeEnd)
-}
-
 const emptyString = ""
 const yesString = "yes"
 const noString = "no"
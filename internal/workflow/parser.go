@@ -1,178 +1,58 @@
 package workflow
 
 import (
-	"log"
-	"sort"
-
 	"github.com/tmknom/actdocs/internal/conf"
+	"github.com/tmknom/actdocs/internal/model"
 	"github.com/tmknom/actdocs/internal/util"
 	"gopkg.in/yaml.v2"
 )
 
 type Parser struct {
-	*AST
+	*model.Document
 	*conf.SortConfig
 }
 
 func NewParser(sort *conf.SortConfig) *Parser {
 	return &Parser{
-		AST: &AST{
-			Inputs:      []*InputAST{},
-			Secrets:     []*SecretAST{},
-			Outputs:     []*OutputAST{},
-			Permissions: []*PermissionAST{},
-		},
+		Document:   model.NewDocument(util.WorkflowKind),
 		SortConfig: sort,
 	}
 }
 
-func (p *Parser) Parse(yamlBytes []byte) (*AST, error) {
+func (p *Parser) Parse(yamlBytes []byte) (*model.Document, error) {
 	content := &Yaml{}
 	err := yaml.Unmarshal(yamlBytes, content)
 	if err != nil {
 		return nil, err
 	}
 
-	for name, value := range content.WorkflowInputs() {
+	p.Name = util.NewNullString(content.Name)
+
+	for name, value := range content.WorkflowInputs().All() {
 		input := p.parseInput(name, value)
 		p.Inputs = append(p.Inputs, input)
 	}
 
-	for name, value := range content.WorkflowOutputs() {
+	for name, value := range content.WorkflowOutputs().All() {
 		output := p.parseOutput(name, value)
 		p.Outputs = append(p.Outputs, output)
 	}
 
-	for name, value := range content.WorkflowSecrets() {
+	for name, value := range content.WorkflowSecrets().All() {
 		secret := p.parseSecret(name, value)
 		p.Secrets = append(p.Secrets, secret)
 	}
 
-	for scope, access := range content.WorkflowPermissions() {
-		permission := NewPermissionAST(scope.(string), access.(string))
+	for scope, access := range content.WorkflowPermissions().All() {
+		permission := model.NewPermission(scope, access)
 		p.Permissions = append(p.Permissions, permission)
 	}
 
-	p.sort()
-	return p.AST, nil
-}
-
-func (p *Parser) sort() {
-	switch {
-	case p.SortConfig.Sort:
-		p.sortInputs()
-		p.sortSecrets()
-		p.sortOutputsByName()
-		p.sortPermissionsByScope()
-	case p.SortConfig.SortByName:
-		p.sortInputsByName()
-		p.sortSecretsByName()
-		p.sortOutputsByName()
-		p.sortPermissionsByScope()
-	case p.SortConfig.SortByRequired:
-		p.sortInputsByRequired()
-		p.sortSecretByRequired()
-	}
-}
-
-func (p *Parser) sortInputs() {
-	log.Printf("sorted: inputs")
-
-	//goland:noinspection GoPreferNilSlice
-	required := []*InputAST{}
-	//goland:noinspection GoPreferNilSlice
-	notRequired := []*InputAST{}
-	for _, input := range p.Inputs {
-		if input.Required.IsTrue() {
-			required = append(required, input)
-		} else {
-			notRequired = append(notRequired, input)
-		}
-	}
-
-	sort.Slice(required, func(i, j int) bool {
-		return required[i].Name < required[j].Name
-	})
-	sort.Slice(notRequired, func(i, j int) bool {
-		return notRequired[i].Name < notRequired[j].Name
-	})
-	p.Inputs = append(required, notRequired...)
-}
-
-func (p *Parser) sortInputsByName() {
-	log.Printf("sorted: inputs by name")
-	item := p.Inputs
-	sort.Slice(item, func(i, j int) bool {
-		return item[i].Name < item[j].Name
-	})
-}
-
-func (p *Parser) sortInputsByRequired() {
-	log.Printf("sorted: inputs by required")
-	item := p.Inputs
-	sort.Slice(item, func(i, j int) bool {
-		return item[i].Required.IsTrue()
-	})
-}
-
-func (p *Parser) sortSecrets() {
-	log.Printf("sorted: secrets")
-
-	//goland:noinspection GoPreferNilSlice
-	required := []*SecretAST{}
-	//goland:noinspection GoPreferNilSlice
-	notRequired := []*SecretAST{}
-	for _, input := range p.Secrets {
-		if input.Required.IsTrue() {
-			required = append(required, input)
-		} else {
-			notRequired = append(notRequired, input)
-		}
-	}
-
-	sort.Slice(required, func(i, j int) bool {
-		return required[i].Name < required[j].Name
-	})
-	sort.Slice(notRequired, func(i, j int) bool {
-		return notRequired[i].Name < notRequired[j].Name
-	})
-	p.Secrets = append(required, notRequired...)
-}
-
-func (p *Parser) sortSecretsByName() {
-	log.Printf("sorted: secrets by name")
-	item := p.Secrets
-	sort.Slice(item, func(i, j int) bool {
-		return item[i].Name < item[j].Name
-	})
-}
-
-func (p *Parser) sortSecretByRequired() {
-	log.Printf("sorted: secrets by required")
-	item := p.Secrets
-	sort.Slice(item, func(i, j int) bool {
-		return item[i].Required.IsTrue()
-	})
-}
-
-func (p *Parser) sortOutputsByName() {
-	log.Printf("sorted: outputs by name")
-	item := p.Outputs
-	sort.Slice(item, func(i, j int) bool {
-		return item[i].Name < item[j].Name
-	})
-}
-
-func (p *Parser) sortPermissionsByScope() {
-	log.Printf("sorted: permission by scope")
-	item := p.Permissions
-	sort.Slice(item, func(i, j int) bool {
-		return item[i].Scope < item[j].Scope
-	})
+	return p.Document.Sort(p.SortConfig), nil
 }
 
-func (p *Parser) parseInput(name string, value *InputYaml) *InputAST {
-	result := NewInputAST(name)
+func (p *Parser) parseInput(name string, value *InputYaml) *model.Input {
+	result := model.NewInput(name)
 	if value == nil {
 		return result
 	}
@@ -185,8 +65,8 @@ func (p *Parser) parseInput(name string, value *InputYaml) *InputAST {
 	return result
 }
 
-func (p *Parser) parseSecret(name string, value *SecretYaml) *SecretAST {
-	result := NewSecretAST(name)
+func (p *Parser) parseSecret(name string, value *SecretYaml) *model.Secret {
+	result := model.NewSecret(name)
 	if value == nil {
 		return result
 	}
@@ -197,8 +77,8 @@ func (p *Parser) parseSecret(name string, value *SecretYaml) *SecretAST {
 	return result
 }
 
-func (p *Parser) parseOutput(name string, value *OutputYaml) *OutputAST {
-	result := NewOutputAST(name)
+func (p *Parser) parseOutput(name string, value *OutputYaml) *model.Output {
+	result := model.NewOutput(name)
 	if value == nil {
 		return result
 	}
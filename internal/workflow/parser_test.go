@@ -6,60 +6,74 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/tmknom/actdocs/internal/conf"
+	"github.com/tmknom/actdocs/internal/model"
+	"github.com/tmknom/actdocs/internal/util"
 )
 
 func TestParser_Parse(t *testing.T) {
 	cases := []struct {
 		name     string
 		fixture  string
-		expected *AST
+		expected *model.Document
 	}{
 		{
 			name:    "empty parameter",
 			fixture: emptyWorkflowFixture,
-			expected: &AST{
-				Inputs: []*InputAST{
-					{"empty", NewNullValue(), NewNullValue(), NewNullValue(), NewNullValue()},
+			expected: &model.Document{
+				Kind:        util.WorkflowKind,
+				Name:        NewNullValue(),
+				Description: NewNullValue(),
+				Inputs: []*model.Input{
+					{Name: "empty", Default: NewNullValue(), Description: NewNullValue(), Required: NewNullValue(), Type: NewNullValue(), DeprecationMessage: NewNullValue()},
 				},
-				Secrets:     []*SecretAST{},
-				Outputs:     []*OutputAST{},
-				Permissions: []*PermissionAST{},
+				Secrets:     []*model.Secret{},
+				Outputs:     []*model.Output{},
+				Permissions: []*model.Permission{},
 			},
 		},
 		{
 			name:    "full parameter",
 			fixture: fullWorkflowFixture,
-			expected: &AST{
-				Inputs: []*InputAST{
-					{"full-number", NewNotNullValue("5"), NewNotNullValue("The full number value."), NewNotNullValue("false"), NewNotNullValue("number")},
+			expected: &model.Document{
+				Kind:        util.WorkflowKind,
+				Name:        NewNullValue(),
+				Description: NewNullValue(),
+				Inputs: []*model.Input{
+					{Name: "full-number", Default: NewNotNullValue("5"), Description: NewNotNullValue("The full number value."), Required: NewNotNullValue("false"), Type: NewNotNullValue("number"), DeprecationMessage: NewNullValue()},
 				},
-				Secrets:     []*SecretAST{},
-				Outputs:     []*OutputAST{},
-				Permissions: []*PermissionAST{},
+				Secrets:     []*model.Secret{},
+				Outputs:     []*model.Output{},
+				Permissions: []*model.Permission{},
 			},
 		},
 		{
 			name:    "complex parameter",
 			fixture: complexWorkflowFixture,
-			expected: &AST{
-				Inputs: []*InputAST{
-					{"full-string", NewNotNullValue(""), NewNotNullValue("The full string value."), NewNotNullValue("true"), NewNotNullValue("string")},
-					{"full-boolean", NewNotNullValue("true"), NewNotNullValue("The full boolean value."), NewNotNullValue("false"), NewNotNullValue("boolean")},
-					{"empty", NewNullValue(), NewNullValue(), NewNullValue(), NewNullValue()},
+			expected: &model.Document{
+				Kind:        util.WorkflowKind,
+				Name:        NewNullValue(),
+				Description: NewNullValue(),
+				Inputs: []*model.Input{
+					{Name: "full-string", Default: NewNotNullValue(""), Description: NewNotNullValue("The full string value."), Required: NewNotNullValue("true"), Type: NewNotNullValue("string"), DeprecationMessage: NewNullValue()},
+					{Name: "full-boolean", Default: NewNotNullValue("true"), Description: NewNotNullValue("The full boolean value."), Required: NewNotNullValue("false"), Type: NewNotNullValue("boolean"), DeprecationMessage: NewNullValue()},
+					{Name: "empty", Default: NewNullValue(), Description: NewNullValue(), Required: NewNullValue(), Type: NewNullValue(), DeprecationMessage: NewNullValue()},
 				},
-				Secrets:     []*SecretAST{},
-				Outputs:     []*OutputAST{},
-				Permissions: []*PermissionAST{},
+				Secrets:     []*model.Secret{},
+				Outputs:     []*model.Output{},
+				Permissions: []*model.Permission{},
 			},
 		},
 		{
 			name:    "invalid YAML",
 			fixture: invalidWorkflowFixture,
-			expected: &AST{
-				Inputs:      []*InputAST{},
-				Secrets:     []*SecretAST{},
-				Outputs:     []*OutputAST{},
-				Permissions: []*PermissionAST{},
+			expected: &model.Document{
+				Kind:        util.WorkflowKind,
+				Name:        NewNotNullValue("Test"),
+				Description: NewNullValue(),
+				Inputs:      []*model.Input{},
+				Secrets:     []*model.Secret{},
+				Outputs:     []*model.Output{},
+				Permissions: []*model.Permission{},
 			},
 		},
 	}
@@ -71,7 +85,7 @@ func TestParser_Parse(t *testing.T) {
 			t.Fatalf("%s: unexpected error: %s", tc.name, err)
 		}
 
-		sort := func(a, b *InputAST) bool { return a.Name < b.Name }
+		sort := func(a, b *model.Input) bool { return a.Name < b.Name }
 		if diff := cmp.Diff(got, tc.expected, cmpopts.SortSlices(sort)); diff != "" {
 			t.Errorf("%s: diff: %s", tc.name, diff)
 		}
@@ -121,3 +135,45 @@ inputs:
     required: false
     description: "The full number value."
 `
+
+func TestParser_ParseWithDeclarationOrder(t *testing.T) {
+	fixture := `
+on:
+  workflow_call:
+    inputs:
+      zulu:
+      alpha:
+    secrets:
+      second:
+      first:
+    outputs:
+      mike:
+      bravo:
+permissions:
+  pull-requests: write
+  contents: read
+`
+	for i := 0; i < 10; i++ {
+		got, err := NewParser(conf.DefaultSortConfig()).Parse([]byte(fixture))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		names := []string{}
+		for _, input := range got.Inputs {
+			names = append(names, input.Name)
+		}
+		for _, secret := range got.Secrets {
+			names = append(names, secret.Name)
+		}
+		for _, output := range got.Outputs {
+			names = append(names, output.Name)
+		}
+		for _, permission := range got.Permissions {
+			names = append(names, permission.Scope)
+		}
+		if diff := cmp.Diff(names, []string{"zulu", "alpha", "second", "first", "mike", "bravo", "pull-requests", "contents"}); diff != "" {
+			t.Fatalf("diff: %s", diff)
+		}
+	}
+}
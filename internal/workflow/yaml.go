@@ -1,8 +1,11 @@
 package workflow
 
+import "github.com/tmknom/actdocs/internal/util"
+
 type Yaml struct {
-	On          *OnYaml     `yaml:"on"`
-	Permissions interface{} `yaml:"permissions"`
+	Name        *string          `yaml:"name"`
+	On          *OnYaml          `yaml:"on"`
+	Permissions *PermissionsYaml `yaml:"permissions"`
 }
 
 type OnYaml struct {
@@ -10,9 +13,9 @@ type OnYaml struct {
 }
 
 type WorkflowCallYaml struct {
-	Inputs  map[string]*InputYaml  `yaml:"inputs"`
-	Secrets map[string]*SecretYaml `yaml:"secrets"`
-	Outputs map[string]*OutputYaml `yaml:"outputs"`
+	Inputs  *util.OrderedMap[*InputYaml]  `yaml:"inputs"`
+	Secrets *util.OrderedMap[*SecretYaml] `yaml:"secrets"`
+	Outputs *util.OrderedMap[*OutputYaml] `yaml:"outputs"`
 }
 
 type InputYaml struct {
@@ -31,42 +34,53 @@ type OutputYaml struct {
 	Description *string `mapstructure:"description"`
 }
 
-func (y *Yaml) WorkflowInputs() map[string]*InputYaml {
-	if y.On == nil || y.On.WorkflowCall == nil || y.On.WorkflowCall.Inputs == nil {
-		return map[string]*InputYaml{}
+// PermissionsYaml is the permissions of the workflow, which is either the access of all scopes or the access by scope.
+type PermissionsYaml struct {
+	*util.OrderedMap[string]
+}
+
+func (y *PermissionsYaml) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var access string
+	if err := unmarshal(&access); err == nil {
+		y.OrderedMap = util.NewOrderedMap[string]()
+		if access == ReadAllAccess || access == WriteAllAccess {
+			y.Keys = []string{AllScope}
+			y.Values[AllScope] = access
+		}
+		return nil
+	}
+
+	y.OrderedMap = util.NewOrderedMap[string]()
+	return unmarshal(y.OrderedMap)
+}
+
+func (y *Yaml) WorkflowInputs() *util.OrderedMap[*InputYaml] {
+	if y.On == nil || y.On.WorkflowCall == nil {
+		return nil
 	}
 	return y.On.WorkflowCall.Inputs
 }
 
-func (y *Yaml) WorkflowSecrets() map[string]*SecretYaml {
-	if y.On == nil || y.On.WorkflowCall == nil || y.On.WorkflowCall.Secrets == nil {
-		return map[string]*SecretYaml{}
+func (y *Yaml) WorkflowSecrets() *util.OrderedMap[*SecretYaml] {
+	if y.On == nil || y.On.WorkflowCall == nil {
+		return nil
 	}
 	return y.On.WorkflowCall.Secrets
 }
 
-func (y *Yaml) WorkflowOutputs() map[string]*OutputYaml {
-	if y.On == nil || y.On.WorkflowCall == nil || y.On.WorkflowCall.Outputs == nil {
-		return map[string]*OutputYaml{}
+func (y *Yaml) WorkflowOutputs() *util.OrderedMap[*OutputYaml] {
+	if y.On == nil || y.On.WorkflowCall == nil {
+		return nil
 	}
 	return y.On.WorkflowCall.Outputs
 }
 
-func (y *Yaml) WorkflowPermissions() map[interface{}]interface{} {
+// WorkflowPermissions returns the access by scope, where nil means no permissions.
+func (y *Yaml) WorkflowPermissions() *util.OrderedMap[string] {
 	if y.Permissions == nil {
-		return map[interface{}]interface{}{}
-	}
-
-	switch y.Permissions.(type) {
-	case string:
-		access := y.Permissions.(string)
-		if access == ReadAllAccess || access == WriteAllAccess {
-			return map[interface{}]interface{}{AllScope: access}
-		}
-	case map[interface{}]interface{}:
-		return y.Permissions.(map[interface{}]interface{})
+		return nil
 	}
-	return map[interface{}]interface{}{}
+	return y.Permissions.OrderedMap
 }
 
 const ReadAllAccess = "read-all"
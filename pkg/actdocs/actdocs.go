@@ -0,0 +1,196 @@
+package actdocs
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/tmknom/actdocs/internal/action"
+	"github.com/tmknom/actdocs/internal/conf"
+	"github.com/tmknom/actdocs/internal/model"
+	"github.com/tmknom/actdocs/internal/util"
+	"github.com/tmknom/actdocs/internal/workflow"
+)
+
+// Kind is the kind of the parsed file.
+type Kind string
+
+const (
+	// KindAction is Custom Action such as action.yml.
+	KindAction Kind = util.ActionKind
+	// KindWorkflow is Reusable Workflow such as .github/workflows/lint.yml.
+	KindWorkflow Kind = util.WorkflowKind
+)
+
+// ErrUnknownKind is returned if the kind can't be detected from the YAML.
+var ErrUnknownKind = errors.New("unknown kind: neither Custom Action nor Reusable Workflow")
+
+// Document is the parsed Custom Action or Reusable Workflow, which is read-only.
+// The accessors such as Inputs return copies, so modifying them changes neither the document nor the rendering.
+// Secrets and Permissions are always empty for Custom Actions.
+type Document struct {
+	spec *model.Spec
+}
+
+// Kind returns the kind of the document.
+func (d *Document) Kind() Kind {
+	return Kind(d.spec.Kind)
+}
+
+// Name returns the name, or empty string if not specified.
+func (d *Document) Name() string {
+	return d.spec.Name.Value
+}
+
+// Description returns the description, or empty string if not specified.
+func (d *Document) Description() string {
+	return d.spec.Description.Value
+}
+
+// Inputs returns the copies of the inputs in the order of the options.
+func (d *Document) Inputs() []*Input {
+	//goland:noinspection GoPreferNilSlice
+	result := []*Input{}
+	for _, input := range d.spec.Inputs {
+		result = append(result, &Input{
+			Name:               input.Name,
+			Description:        input.Description.Value,
+			Default:            valueOrNil(input.Default),
+			Required:           input.Required.IsTrue(),
+			Type:               input.Type.Value,
+			DeprecationMessage: input.DeprecationMessage.Value,
+		})
+	}
+	return result
+}
+
+// Secrets returns the copies of the secrets in the order of the options.
+func (d *Document) Secrets() []*Secret {
+	//goland:noinspection GoPreferNilSlice
+	result := []*Secret{}
+	for _, secret := range d.spec.Secrets {
+		result = append(result, &Secret{Name: secret.Name, Description: secret.Description.Value, Required: secret.Required.IsTrue()})
+	}
+	return result
+}
+
+// Outputs returns the copies of the outputs in the order of the options.
+func (d *Document) Outputs() []*Output {
+	//goland:noinspection GoPreferNilSlice
+	result := []*Output{}
+	for _, output := range d.spec.Outputs {
+		result = append(result, &Output{Name: output.Name, Description: output.Description.Value})
+	}
+	return result
+}
+
+// Permissions returns the copies of the permissions in the order of the options.
+func (d *Document) Permissions() []*Permission {
+	//goland:noinspection GoPreferNilSlice
+	result := []*Permission{}
+	for _, permission := range d.spec.Permissions {
+		result = append(result, &Permission{Scope: permission.Scope, Access: permission.Access})
+	}
+	return result
+}
+
+type Input struct {
+	Name        string
+	Description string
+	// Default is nil if the default value isn't specified.
+	Default  *string
+	Required bool
+	// Type is specified only for Reusable Workflows.
+	Type string
+	// DeprecationMessage is specified only for Custom Actions.
+	DeprecationMessage string
+}
+
+type Secret struct {
+	Name        string
+	Description string
+	Required    bool
+}
+
+type Output struct {
+	Name        string
+	Description string
+}
+
+type Permission struct {
+	Scope  string
+	Access string
+}
+
+// ParseFile parses the file, detecting the kind automatically. The nil options is the same as the zero value.
+func ParseFile(path string, opts *Options) (*Document, error) {
+	yaml, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(yaml, opts)
+}
+
+// Parse parses the YAML, detecting the kind automatically, or returns ErrUnknownKind.
+func Parse(yaml []byte, opts *Options) (*Document, error) {
+	kind := Kind(util.DetectKind(yaml))
+	if kind == "" {
+		return nil, ErrUnknownKind
+	}
+	return ParseKind(yaml, kind, opts)
+}
+
+// ParseKind parses the YAML as the kind, such as the YAML the kind can't be detected from.
+func ParseKind(yaml []byte, kind Kind, opts *Options) (*Document, error) {
+	formatter := opts.formatterConfig()
+	if err := formatter.Validate(); err != nil {
+		return nil, err
+	}
+
+	var document *model.Document
+	var err error
+	switch kind {
+	case KindAction:
+		document, err = action.NewParser(conf.DefaultSortConfig()).Parse(yaml)
+	case KindWorkflow:
+		document, err = workflow.NewParser(conf.DefaultSortConfig()).Parse(yaml)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKind, kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Document{spec: model.NewSortedSpec(document, opts.sortConfig(), formatter)}, nil
+}
+
+// Markdown returns the documentation in markdown.
+func (d *Document) Markdown() string {
+	return d.spec.ToMarkdown()
+}
+
+// JSON returns the documentation in JSON.
+func (d *Document) JSON() string {
+	return d.spec.ToJson()
+}
+
+// Inject replaces the content between the injection comments such as "<!-- actdocs start -->"
+// and "<!-- actdocs end -->" in the template with the documentation, and returns the injected template.
+// The "source" attribute of the injection comments isn't supported.
+func Inject(template io.Reader, doc *Document) (string, error) {
+	resolver := func(source string) (util.Document, error) {
+		if source != "" {
+			return nil, fmt.Errorf("invalid attribute: %s is not supported", util.SourceAttribute)
+		}
+		return doc.spec, nil
+	}
+	return util.NewInjector(template, conf.AllSections, resolver).Inject()
+}
+
+func valueOrNil(s *util.NullString) *string {
+	if !s.IsValid() {
+		return nil
+	}
+	value := s.Value
+	return &value
+}
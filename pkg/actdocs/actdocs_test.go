@@ -0,0 +1,230 @@
+package actdocs
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name     string
+		yaml     string
+		opts     *Options
+		expected *documentFields
+	}{
+		{
+			name: "action",
+			yaml: testActionYaml,
+			opts: &Options{Sort: SortByName},
+			expected: &documentFields{
+				Kind:        KindAction,
+				Name:        "Example",
+				Description: "An example.",
+				Inputs: []*Input{
+					{Name: "answer", Description: "The answer.", Default: stringPtr("42"), Required: true},
+					{Name: "hello", Description: "The greeting.", DeprecationMessage: "Use answer."},
+				},
+				Secrets:     []*Secret{},
+				Outputs:     []*Output{{Name: "result", Description: "The result."}},
+				Permissions: []*Permission{},
+			},
+		},
+		{
+			name: "workflow",
+			yaml: testWorkflowYaml,
+			opts: nil,
+			expected: &documentFields{
+				Kind:        KindWorkflow,
+				Name:        "Example Workflow",
+				Inputs:      []*Input{{Name: "number", Default: stringPtr("5"), Type: "number"}},
+				Secrets:     []*Secret{{Name: "token", Description: "The token.", Required: true}},
+				Outputs:     []*Output{},
+				Permissions: []*Permission{{Scope: "contents", Access: "read"}},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		got, err := Parse([]byte(tc.yaml), tc.opts)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.name, err)
+		}
+		if diff := cmp.Diff(fieldsOf(got), tc.expected); diff != "" {
+			t.Errorf("%s: diff: %s", tc.name, diff)
+		}
+	}
+}
+
+func TestParseWithError(t *testing.T) {
+	_, err := Parse([]byte("name: Unknown\n"), nil)
+	if !errors.Is(err, ErrUnknownKind) {
+		t.Errorf("expected: %s, but got: %v", ErrUnknownKind, err)
+	}
+
+	_, err = Parse([]byte(testActionYaml), &Options{Sections: []string{"foo"}})
+	expected := "unknown section: foo, valid values are [description inputs secrets outputs permissions]"
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected: %s, but got: %v", expected, err)
+	}
+}
+
+func TestParseFile(t *testing.T) {
+	got, err := ParseFile(testBaseDir+"testdata/valid-empty-workflow.yml", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got.Kind() != KindWorkflow || got.Name() != "Valid Empty Workflow" {
+		t.Errorf("unexpected document: %#v", fieldsOf(got))
+	}
+}
+
+func TestDocument_ReadOnly(t *testing.T) {
+	doc, err := Parse([]byte(testActionYaml), &Options{Sections: []string{SectionOutputs}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := doc.Markdown()
+
+	outputs := doc.Outputs()
+	outputs[0].Name = "modified"
+	_ = append(doc.Inputs()[:0], &Input{Name: "added"})
+
+	if got := doc.Outputs()[0].Name; got != "result" {
+		t.Errorf("expected the outputs are copied, but got: %s", got)
+	}
+	if got := len(doc.Inputs()); got != 2 {
+		t.Errorf("expected the inputs are copied, but got: %d", got)
+	}
+	if diff := cmp.Diff(doc.Markdown(), expected); diff != "" {
+		t.Errorf("expected the rendering is unchanged: %s", diff)
+	}
+}
+
+func TestDocument_Markdown(t *testing.T) {
+	doc, err := Parse([]byte(testActionYaml), &Options{Sections: []string{SectionOutputs}, HeadingOffset: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := "### Outputs\n\n| Name | Description |\n| :--- | :---------- |\n| result | The result. |"
+	if diff := cmp.Diff(doc.Markdown(), expected); diff != "" {
+		t.Errorf("diff: %s", diff)
+	}
+}
+
+func TestDocument_MarkdownWithLayout(t *testing.T) {
+	doc, err := Parse([]byte(testActionYaml), &Options{Sections: []string{SectionOutputs}, Layouts: map[string]string{SectionOutputs: LayoutList}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := "## Outputs\n\n### result\n\nThe result."
+	if diff := cmp.Diff(doc.Markdown(), expected); diff != "" {
+		t.Errorf("diff: %s", diff)
+	}
+}
+
+func TestInject(t *testing.T) {
+	doc, err := Parse([]byte(testActionYaml), &Options{Columns: map[string][]string{SectionInputs: {"name", "required"}}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	template := "# Example\n\n<!-- actdocs inputs start -->\n<!-- actdocs inputs end -->\n"
+	got, err := Inject(strings.NewReader(template), doc)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := "# Example\n\n<!-- actdocs inputs start -->\n\n## Inputs\n\n| Name | Required |\n| :--- | :------: |\n| hello | no |\n| answer | yes |\n\n<!-- actdocs inputs end -->\n"
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("diff: %s", diff)
+	}
+}
+
+func ExampleParse() {
+	doc, err := Parse([]byte(testActionYaml), &Options{Sort: SortByRequiredAndName})
+	if err != nil {
+		panic(err)
+	}
+	for _, input := range doc.Inputs() {
+		fmt.Printf("%s: required=%t\n", input.Name, input.Required)
+	}
+	// Output:
+	// answer: required=true
+	// hello: required=false
+}
+
+func stringPtr(value string) *string {
+	return &value
+}
+
+const testBaseDir = "../../"
+
+const testActionYaml = `name: Example
+description: An example.
+inputs:
+  hello:
+    description: The greeting.
+    deprecationMessage: Use answer.
+  answer:
+    description: The answer.
+    default: 42
+    required: true
+outputs:
+  result:
+    description: The result.
+    value: ${{ steps.main.outputs.result }}
+runs:
+  using: composite
+  steps:
+    - run: echo
+      shell: bash
+`
+
+const testWorkflowYaml = `name: Example Workflow
+on:
+  workflow_call:
+    inputs:
+      number:
+        type: number
+        default: 5
+    secrets:
+      token:
+        description: The token.
+        required: true
+permissions:
+  contents: read
+jobs:
+  run:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo
+`
+
+// documentFields is the values of the accessors of Document to compare.
+type documentFields struct {
+	Kind        Kind
+	Name        string
+	Description string
+	Inputs      []*Input
+	Secrets     []*Secret
+	Outputs     []*Output
+	Permissions []*Permission
+}
+
+func fieldsOf(doc *Document) *documentFields {
+	return &documentFields{
+		Kind:        doc.Kind(),
+		Name:        doc.Name(),
+		Description: doc.Description(),
+		Inputs:      doc.Inputs(),
+		Secrets:     doc.Secrets(),
+		Outputs:     doc.Outputs(),
+		Permissions: doc.Permissions(),
+	}
+}
@@ -0,0 +1,25 @@
+// Package actdocs generates documentation from Custom Actions and Reusable Workflows.
+//
+// Parse a file into a Document, then render it as markdown or JSON, or inject it into an existing template:
+//
+//	doc, err := actdocs.ParseFile("action.yml", &actdocs.Options{Sort: actdocs.SortByRequiredAndName})
+//	if err != nil {
+//		return err
+//	}
+//	fmt.Println(doc.Markdown())
+//
+// The Document is read-only: the accessors such as Inputs return copies,
+// and the rendering always reflects the parsed file with the options.
+//
+// # Compatibility
+//
+// This package follows semantic versioning of actdocs:
+//
+//   - Exported identifiers aren't removed or changed incompatibly within a major version.
+//   - Fields may be added to structs, so use keyed struct literals.
+//   - Constants may be added, so handle unknown values such as a new Kind.
+//   - The generated markdown may change in minor versions such as fixing escaping, the same as the actdocs command.
+//     The JSON keeps its keys, while keys may be added.
+//
+// Anything under the internal directory isn't covered by these guarantees.
+package actdocs
@@ -0,0 +1,105 @@
+package actdocs
+
+import "github.com/tmknom/actdocs/internal/conf"
+
+// Options configures the generated documentation. The zero value renders the same as the actdocs command.
+type Options struct {
+	// Omit omits sections without items.
+	Omit bool
+	// Sections renders the sections in order, such as SectionInputs, or all sections if empty.
+	Sections []string
+	// OmitSections omits the sections without items.
+	OmitSections []string
+	// HeadingOffset is added to the heading level of sections.
+	HeadingOffset int
+	// Titles overrides the section titles by section.
+	Titles map[string]string
+	// Columns renders the table columns in order by section, such as {SectionInputs: {"name", "required"}}.
+	Columns map[string][]string
+	// Sort sorts the items.
+	Sort SortOrder
+	// Layout renders the items as LayoutTable or LayoutList in markdown.
+	Layout string
+	// Layouts overrides the layout by section, such as {SectionInputs: LayoutList}.
+	Layouts map[string]string
+}
+
+// Layouts of the items in markdown.
+const (
+	LayoutTable = conf.TableLayout
+	LayoutList  = conf.ListLayout
+)
+
+// SortOrder is the order of the items.
+type SortOrder int
+
+const (
+	// SortNone keeps the order in the file.
+	SortNone SortOrder = iota
+	// SortByRequiredAndName sorts by required, then by name.
+	SortByRequiredAndName
+	// SortByName sorts by name.
+	SortByName
+	// SortByRequired sorts by required.
+	SortByRequired
+)
+
+// Sections of the documentation.
+const (
+	SectionDescription = conf.DescriptionSection
+	SectionInputs      = conf.InputsSection
+	SectionSecrets     = conf.SecretsSection
+	SectionOutputs     = conf.OutputsSection
+	SectionPermissions = conf.PermissionsSection
+)
+
+// Validate returns error if the options contain unknown sections or columns.
+func (o *Options) Validate() error {
+	return o.formatterConfig().Validate()
+}
+
+func (o *Options) formatterConfig() *conf.FormatterConfig {
+	config := conf.DefaultFormatterConfig()
+	if o == nil {
+		return config
+	}
+
+	config.Omit = o.Omit
+	config.HeadingOffset = o.HeadingOffset
+	if o.Sections != nil {
+		config.Sections = o.Sections
+	}
+	if o.OmitSections != nil {
+		config.OmitSections = o.OmitSections
+	}
+	for section, title := range o.Titles {
+		config.Titles[section] = title
+	}
+	for section, columns := range o.Columns {
+		config.Columns[section] = columns
+	}
+	if o.Layout != "" {
+		config.Layout = o.Layout
+	}
+	for section, layout := range o.Layouts {
+		config.Layouts[section] = layout
+	}
+	return config
+}
+
+func (o *Options) sortConfig() *conf.SortConfig {
+	config := conf.DefaultSortConfig()
+	if o == nil {
+		return config
+	}
+
+	switch o.Sort {
+	case SortByRequiredAndName:
+		config.Sort = true
+	case SortByName:
+		config.SortByName = true
+	case SortByRequired:
+		config.SortByRequired = true
+	}
+	return config
+}